@@ -0,0 +1,394 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jbrekelmans/go-graphql"
+	internalJSON "github.com/jbrekelmans/go-graphql/json"
+	"github.com/jbrekelmans/go-graphql/mapping"
+)
+
+// BatchQuery is a query of a batch. See (*Client).QueryBatch.
+type BatchQuery struct {
+	// Query is a pointer to a struct that defines the GraphQL query, and also receives the response data.
+	Query any
+
+	// Variables are the variables of Query.
+	Variables map[string]any
+
+	// Err is set by (*Client).QueryBatch to the error of the query, if any.
+	// GraphQL-level errors are reported as an *Error that only includes the response errors of the query.
+	Err error
+
+	// Response is set by (*Client).QueryBatch to the response of the request that included the query.
+	Response *http.Response
+}
+
+// BatchOptions are options for (*Client).QueryBatch and (*Client).BulkMutate.
+type BatchOptions struct {
+	// MaxQueries is the maximum number of queries (or mutations) per request. Defaults to 20.
+	MaxQueries int
+
+	// MaxDocumentSize is the maximum size in bytes of the GraphQL document of a request, including the operation type, name
+	// and variable definitions. Defaults to 32 KiB.
+	// A query is always sent, even if the size of its GraphQL document exceeds MaxDocumentSize.
+	MaxDocumentSize int
+
+	// MaxNodeCount is the maximum node count (see AnalyzeCost) of a request. Defaults to MaxNodeCount.
+	// A query is always sent, even if its node count exceeds MaxNodeCount. A query whose node count cannot be analyzed (e.g.
+	// because the variable of a first or last argument is a nil pointer) is sent in a request of its own.
+	MaxNodeCount int
+
+	// Interval is the minimum duration between sending consecutive requests. It is measured from the time the previous request
+	// was written to the connection (or, if the transport of the *http.Client does not report that, from the time the
+	// previous request was passed to the *http.Client) to the time the next request is passed to the *http.Client.
+	// GitHub recommends pacing mutations to avoid secondary rate limits.
+	Interval time.Duration
+}
+
+// QueryBatch sends queries in as few requests as possible.
+// The root fields and variables of each query are renamed using aliases, so that queries do not collide and can be
+// combined in one GraphQL document. If the queries do not fit in one request (see BatchOptions) then they are split into
+// several requests. The response data and response errors are demultiplexed into the Query and Err of each query.
+//
+// Root fields of queries must not be inline fragments or embedded structs.
+//
+// Returns the first non-nil Err of the queries.
+func (c *Client) QueryBatch(ctx context.Context, queries []*BatchQuery, opts *BatchOptions) error {
+	return c.doBatch(ctx, "query", queries, opts)
+}
+
+func (c *Client) doBatch(ctx context.Context, operationType string, queries []*BatchQuery, opts *BatchOptions) error {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	maxQueries := opts.MaxQueries
+	if maxQueries <= 0 {
+		maxQueries = 20
+	}
+	maxDocumentSize := opts.MaxDocumentSize
+	if maxDocumentSize <= 0 {
+		maxDocumentSize = 32 * 1024
+	}
+	maxNodeCount := opts.MaxNodeCount
+	if maxNodeCount <= 0 {
+		maxNodeCount = MaxNodeCount
+	}
+	name, err := operationName(ctx, nil)
+	if err != nil {
+		for _, q := range queries {
+			q.Err = err
+			q.Response = nil
+		}
+		return err
+	}
+	var chunks [][]*batchItem
+	var chunk []*batchItem
+	chunkNodeCount := 0
+	chunkUnknownCost := false
+	for i, q := range queries {
+		q.Err = nil
+		q.Response = nil
+		item, err := newBatchItem(fmt.Sprintf("b%d_", i), q)
+		if err != nil {
+			q.Err = err
+			continue
+		}
+		analysis, err := AnalyzeCost(q.Query, q.Variables)
+		unknownCost := err != nil
+		if len(chunk) > 0 && (len(chunk) >= maxQueries ||
+			c.batchDocumentSize(operationType, name, append(chunk[:len(chunk):len(chunk)], item)) > maxDocumentSize ||
+			chunkNodeCount+analysis.NodeCount > maxNodeCount || unknownCost || chunkUnknownCost) {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			chunkNodeCount = 0
+		}
+		chunk = append(chunk, item)
+		chunkNodeCount += analysis.NodeCount
+		chunkUnknownCost = unknownCost
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+	var mu sync.Mutex
+	var lastSent time.Time
+	onSend := func() {
+		mu.Lock()
+		lastSent = time.Now()
+		mu.Unlock()
+	}
+	for i, chunk := range chunks {
+		if i > 0 && opts.Interval > 0 {
+			mu.Lock()
+			next := lastSent.Add(opts.Interval)
+			mu.Unlock()
+			if err := sleepUntil(ctx, next); err != nil {
+				for _, chunk := range chunks[i:] {
+					for _, item := range chunk {
+						item.q.Err = err
+					}
+				}
+				break
+			}
+		}
+		c.sendBatch(ctx, operationType, name, chunk, onSend)
+	}
+	for _, q := range queries {
+		if q.Err != nil {
+			return q.Err
+		}
+	}
+	return nil
+}
+
+// sleepUntil blocks until t or until ctx is done, whichever happens first.
+// Returns ctx.Err() if ctx is done first.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// batchItem is a query of a batch with renamed root fields and variables.
+type batchItem struct {
+	q          *BatchQuery
+	prefix     string
+	selections string
+	variables  map[string]any
+
+	// varDefs are the variable definitions of the renamed variables, e.g. "$b0_owner:String!".
+	varDefs []string
+}
+
+func newBatchItem(prefix string, q *BatchQuery) (*batchItem, error) {
+	t := reflect.TypeOf(q.Query)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf(`invalid batch query type %T`, q.Query)
+	}
+	qb := queryBuilder{
+		rename: func(graphQL string) string {
+			return renameVariables(graphQL, prefix)
+		},
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		x := mapping.NewFieldInfo(f)
+		if x.Inline() || x.IsInlineFragment() {
+			return nil, fmt.Errorf(`error batching query of type %T: root field %s is an inline fragment or embedded struct`,
+				q.Query, f.Name)
+		}
+		qb.field(aliasField(x, prefix), f.Type)
+	}
+	item := &batchItem{
+		q:          q,
+		prefix:     prefix,
+		selections: qb.String(),
+		variables:  make(map[string]any, len(q.Variables)),
+	}
+	for varName, v := range q.Variables {
+		item.variables[prefix+varName] = v
+		item.varDefs = append(item.varDefs, "$"+prefix+varName+":"+variableType(v))
+	}
+	return item, nil
+}
+
+// batchDocumentSize returns the size in bytes of the GraphQL document that sendBatch sends for items, including the rateLimit
+// field that is added to queries if the *Client captures rate limits (see WithRateLimitCapture).
+func (c *Client) batchDocumentSize(operationType string, operationName string, items []*batchItem) int {
+	size := len(operationType) + len("{}")
+	if c.captureRateLimit && operationType == "query" {
+		// See injectField.
+		size += len(rateLimitSelection)
+		if n := len(items); n > 0 && !strings.HasSuffix(items[n-1].selections, "}") {
+			size += len(",")
+		}
+	}
+	if operationName != "" {
+		size += len(" ") + len(operationName)
+	}
+	varCount := 0
+	for i, item := range items {
+		if i > 0 {
+			size += len(",")
+		}
+		size += len(item.selections)
+		for _, varDef := range item.varDefs {
+			if varCount > 0 {
+				size += len(",")
+			}
+			size += len(varDef)
+			varCount++
+		}
+	}
+	if varCount > 0 {
+		size += len("()")
+	}
+	return size
+}
+
+// aliasField returns the GraphQL snippet of x, with the response key prefixed by prefix.
+func aliasField(x mapping.FieldInfo, prefix string) string {
+	graphQL := strings.TrimSpace(x.GraphQL())
+	responseKey := x.FieldName()
+	if rest := strings.TrimSpace(graphQL[len(responseKey):]); strings.HasPrefix(rest, ":") {
+		// Field already has an alias.
+		graphQL = strings.TrimSpace(rest[1:])
+	}
+	return prefix + responseKey + ": " + graphQL
+}
+
+// renameVariables prefixes the names of all variables referenced in the GraphQL snippet graphQL with prefix.
+// String literals are left as is.
+func renameVariables(graphQL string, prefix string) string {
+	var b strings.Builder
+	inString := false
+	for i := 0; i < len(graphQL); i++ {
+		ch := graphQL[i]
+		b.WriteByte(ch)
+		switch {
+		case inString && ch == '\\' && i+1 < len(graphQL):
+			i++
+			b.WriteByte(graphQL[i])
+		case ch == '"':
+			inString = !inString
+		case !inString && ch == '$':
+			b.WriteString(prefix)
+		}
+	}
+	return b.String()
+}
+
+// sendBatch sends the items of a batch in one request.
+// The operation is named name, unless name is the empty string. onSend is called when the request is sent (see request).
+func (c *Client) sendBatch(ctx context.Context, operationType string, name string, items []*batchItem, onSend func()) {
+	var qb queryBuilder
+	variables := map[string]any{}
+	for _, item := range items {
+		for varName, v := range item.variables {
+			variables[varName] = v
+		}
+	}
+	qb.operationType(operationType, name)
+	qb.varDefs(variables)
+	qb.b.WriteByte('{')
+	for i, item := range items {
+		if i > 0 {
+			qb.b.WriteByte(',')
+		}
+		qb.raw(item.selections)
+	}
+	qb.b.WriteByte('}')
+	operation := qb.String()
+	var data map[string]json.RawMessage
+	resp, errItems, err := c.send(ctx, request{
+		Query:         operation,
+		Variables:     variables,
+		OperationName: name,
+		isQuery:       operationType == "query",
+		onSend:        onSend,
+	}, func(b []byte) error {
+		return json.Unmarshal(b, &data)
+	})
+	for _, item := range items {
+		item.q.Response = resp
+		item.q.Err = item.decode(data)
+	}
+	if err == nil {
+		return
+	}
+	if len(errItems) == 0 {
+		// The request failed as a whole.
+		for _, item := range items {
+			item.q.Err = setOperationName(enhanceError(&graphql.Error{
+				Err:       err,
+				Message:   err.Error(),
+				Operation: operation,
+			}), name)
+		}
+		return
+	}
+	errItemsByItem := make([][]graphql.ErrorItem, len(items))
+	for _, errItem := range errItems {
+		found := false
+		if prefix := errorPathRoot(errItem); prefix != "" {
+			for i, item := range items {
+				if strings.HasPrefix(prefix, item.prefix) {
+					errItemsByItem[i] = append(errItemsByItem[i], errItem)
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			// The error cannot be attributed to a query, so it applies to all queries.
+			for i := range items {
+				errItemsByItem[i] = append(errItemsByItem[i], errItem)
+			}
+		}
+	}
+	for i, item := range items {
+		if len(errItemsByItem[i]) == 0 {
+			continue
+		}
+		errorsJSON, _ := json.Marshal(errItemsByItem[i])
+		itemErr := fmt.Errorf(`%d-response with errors: %s`, resp.StatusCode, string(errorsJSON))
+		if resp.StatusCode != http.StatusOK {
+			itemErr = err
+		}
+		item.q.Err = setOperationName(enhanceError(&graphql.Error{
+			Err:       itemErr,
+			Errors:    errItemsByItem[i],
+			Message:   itemErr.Error(),
+			Operation: operation,
+		}), name)
+	}
+}
+
+// decode decodes the response data of the root fields of item into item.q.Query.
+func (item *batchItem) decode(data map[string]json.RawMessage) error {
+	itemData := map[string]json.RawMessage{}
+	for responseKey, v := range data {
+		if strings.HasPrefix(responseKey, item.prefix) {
+			itemData[responseKey[len(item.prefix):]] = v
+		}
+	}
+	if len(itemData) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(itemData)
+	if err != nil {
+		return err
+	}
+	if err := internalJSON.Unmarshal(b, item.q.Query); err != nil {
+		return fmt.Errorf(`error decoding data of batch query: %w`, err)
+	}
+	return nil
+}
+
+// errorPathRoot returns the first element of the path of errItem, or the empty string if errItem has no path.
+func errorPathRoot(errItem graphql.ErrorItem) string {
+	var path []any
+	if err := json.Unmarshal(errItem.Raw["path"], &path); err != nil || len(path) == 0 {
+		return ""
+	}
+	s, _ := path[0].(string)
+	return s
+}
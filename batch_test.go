@@ -0,0 +1,204 @@
+package githubv4
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jbrekelmans/go-graphql/mapping"
+	"github.com/stretchr/testify/assert"
+)
+
+type batchTestQuery struct {
+	Repository struct {
+		Description string
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func Test_Client_QueryBatch(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		var operations []string
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			operations = append(operations, req.Query)
+			switch len(operations) {
+			case 1:
+				assert.JSONEq(t, `"o0"`, string(req.Variables["b0_owner"]))
+				assert.JSONEq(t, `"n1"`, string(req.Variables["b1_name"]))
+				return http.StatusOK, `{"data":{"b0_repository":{"description":"d0"},"b1_repository":null},` +
+					`"errors":[{"type":"NOT_FOUND","path":["b1_repository"],"message":"Could not resolve to a Repository"}]}`
+			default:
+				return http.StatusOK, `{"data":{"b2_repository":{"description":"d2"}}}`
+			}
+		})
+		var queries []*BatchQuery
+		var qs [3]batchTestQuery
+		for i := range qs {
+			queries = append(queries, &BatchQuery{
+				Query: &qs[i],
+				Variables: map[string]any{
+					"owner": "o" + string(rune('0'+i)),
+					"name":  "n" + string(rune('0'+i)),
+				},
+			})
+		}
+		err := c.QueryBatch(context.Background(), queries, &BatchOptions{MaxQueries: 2})
+		assert.Same(t, queries[1].Err, err)
+		assert.Equal(t, []string{
+			"query($b0_name:String!,$b0_owner:String!,$b1_name:String!,$b1_owner:String!){" +
+				"b0_repository: repository(owner: $b0_owner, name: $b0_name){description}," +
+				"b1_repository: repository(owner: $b1_owner, name: $b1_name){description}}",
+			"query($b2_name:String!,$b2_owner:String!){b2_repository: repository(owner: $b2_owner, name: $b2_name){description}}",
+		}, operations)
+		assert.NoError(t, queries[0].Err)
+		assert.Equal(t, "d0", qs[0].Repository.Description)
+		if assert.IsType(t, &Error{}, queries[1].Err) {
+			errItems := queries[1].Err.(*Error).Errors
+			if assert.Len(t, errItems, 1) {
+				assert.Equal(t, "NOT_FOUND", errItems[0].Type)
+			}
+		}
+		assert.NoError(t, queries[2].Err)
+		assert.Equal(t, "d2", qs[2].Repository.Description)
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusBadGateway, `{}`
+		})
+		var q1, q2 batchTestQuery
+		queries := []*BatchQuery{{Query: &q1}, {Query: &q2}}
+		err := c.QueryBatch(context.Background(), queries, nil)
+		assert.ErrorContains(t, err, "non-success status 502")
+		for _, q := range queries {
+			assert.Error(t, q.Err)
+			if assert.NotNil(t, q.Response) {
+				assert.Equal(t, http.StatusBadGateway, q.Response.StatusCode)
+			}
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		c := NewClient(nil)
+		var q int
+		err := c.QueryBatch(context.Background(), []*BatchQuery{{Query: &q}}, nil)
+		assert.ErrorContains(t, err, "invalid batch query type *int")
+	})
+	t.Run("Case4", func(t *testing.T) {
+		// The selections of two queries fit in MaxDocumentSize, but the document including the variable definitions does not.
+		var operations []string
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			operations = append(operations, req.Query)
+			return http.StatusOK, `{"data":{}}`
+		})
+		var qs [2]batchTestQuery
+		queries := []*BatchQuery{
+			{Query: &qs[0], Variables: map[string]any{"owner": "o", "name": "n"}},
+			{Query: &qs[1], Variables: map[string]any{"owner": "o", "name": "n"}},
+		}
+		err := c.QueryBatch(context.Background(), queries, &BatchOptions{MaxDocumentSize: 200})
+		assert.NoError(t, err)
+		if assert.Len(t, operations, 2) {
+			for _, operation := range operations {
+				assert.LessOrEqual(t, len(operation), 200)
+			}
+		}
+	})
+}
+
+func Test_Client_QueryBatch_UnknownCost(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		// The node count of the second query cannot be analyzed, so it is sent in a request of its own.
+		var operations []string
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			operations = append(operations, req.Query)
+			return http.StatusOK, `{"data":{}}`
+		})
+		type issuesQuery struct {
+			Viewer struct {
+				Issues struct {
+					TotalCount int
+				} `graphql:"issues(first: $first)"`
+			}
+		}
+		var qs [3]issuesQuery
+		queries := []*BatchQuery{
+			{Query: &qs[0], Variables: map[string]any{"first": 1}},
+			{Query: &qs[1], Variables: map[string]any{"first": (*int)(nil)}},
+			{Query: &qs[2], Variables: map[string]any{"first": 1}},
+		}
+		err := c.QueryBatch(context.Background(), queries, nil)
+		assert.NoError(t, err)
+		assert.Len(t, operations, 3)
+	})
+}
+
+func Test_batchDocumentSize(t *testing.T) {
+	for i, opts := range [][]ClientOption{nil, {WithRateLimitCapture()}} {
+		opts := opts
+		t.Run(fmt.Sprintf("Case%d", i+1), func(t *testing.T) {
+			var operation string
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				operation = req.Query
+				return http.StatusOK, `{"data":{}}`
+			}, opts...)
+			var items []*batchItem
+			for i, variables := range []map[string]any{{"owner": "o", "name": "n"}, nil, {"owner": "o", "name": "n"}} {
+				item, err := newBatchItem(fmt.Sprintf("b%d_", i), &BatchQuery{Query: &batchTestQuery{}, Variables: variables})
+				if !assert.NoError(t, err) {
+					return
+				}
+				items = append(items, item)
+			}
+			for _, name := range []string{"", "GetRepositories"} {
+				c.sendBatch(context.Background(), "query", name, items, nil)
+				assert.Equal(t, len(operation), c.batchDocumentSize("query", name, items))
+				c.sendBatch(context.Background(), "query", name, items[1:2], nil)
+				assert.Equal(t, len(operation), c.batchDocumentSize("query", name, items[1:2]))
+			}
+		})
+	}
+}
+
+func Test_newBatchItem(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		// Variables in directives, including directives of inline fragments, are renamed.
+		var q struct {
+			Node struct {
+				Issue struct {
+					Title string
+				} `graphql:"... on Issue @include(if: $withIssue)"`
+			} `graphql:"node(id: $id) @skip(if: $skip)"`
+		}
+		item, err := newBatchItem("b0_", &BatchQuery{
+			Query:     &q,
+			Variables: map[string]any{"id": "I0", "withIssue": true, "skip": false},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "b0_node: node(id: $b0_id) @skip(if: $b0_skip){... on Issue @include(if: $b0_withIssue){title}}",
+				item.selections)
+			assert.ElementsMatch(t, []string{"$b0_id:String!", "$b0_withIssue:Boolean!", "$b0_skip:Boolean!"}, item.varDefs)
+		}
+	})
+}
+
+func Test_renameVariables(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		actual := renameVariables(`search(query: "cost:$5 \"$x\"", first: $first, after: $after)`, "b1_")
+		assert.Equal(t, `search(query: "cost:$5 \"$x\"", first: $b1_first, after: $b1_after)`, actual)
+	})
+}
+
+func Test_aliasField(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		f, _ := reflect.TypeOf(struct {
+			Repo struct{} `graphql:"repo: repository(owner: $owner, name: $name)"`
+		}{}).FieldByName("Repo")
+		assert.Equal(t, "b0_repo: repository(owner: $owner, name: $name)", aliasField(mapping.NewFieldInfo(f), "b0_"))
+	})
+	t.Run("Case2", func(t *testing.T) {
+		f, _ := reflect.TypeOf(struct {
+			Viewer struct{}
+		}{}).FieldByName("Viewer")
+		assert.Equal(t, "b0_viewer: viewer", aliasField(mapping.NewFieldInfo(f), "b0_"))
+	})
+}
@@ -0,0 +1,119 @@
+package githubv4
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// BulkMutateResult is the result of a mutation of (*Client).BulkMutate.
+type BulkMutateResult struct {
+	// Mutation is a pointer to a new value of the type of the template, that received the response data of the mutation.
+	Mutation any
+
+	// Err is the error of the mutation, if any. See BatchQuery.
+	Err error
+
+	// Response is the response of the request that included the mutation.
+	Response *http.Response
+
+	// ClientMutationID is the client mutation ID of the input, if any. See WithClientMutationIDs.
+	ClientMutationID string
+}
+
+// BulkMutate does one mutation per input, packing the mutations into as few requests as possible.
+// template is a pointer to a struct that defines the GraphQL mutation (see Mutate). Each input is bound to variable "input",
+// and is combined with variables (see Query), which is not modified. Returns an error without sending anything if variables
+// defines "input" or if an input is nil.
+// Mutations are combined into requests by renaming root fields and variables using aliases, see QueryBatch.
+// opts.MaxQueries limits the number of mutations per request and opts.Interval paces requests.
+//
+// Failed mutations do not abort the remaining requests. If the *Client validates inputs (see WithInputValidation) then invalid
+// inputs are not sent, and the Err of their results is a *ValidationError.
+// Returns one result per input, and the first non-nil Err of the results.
+func (c *Client) BulkMutate(ctx context.Context, template any, inputs []Input, variables any,
+	opts *BatchOptions) ([]*BulkMutateResult, error) {
+	t := reflect.TypeOf(template)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf(`error in (*Client).BulkMutate: template has non-pointer-to-struct type %T`, template)
+	}
+	variablesByName, err := variablesMap(template, variables, Inputs{"input": nil})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := variablesByName["input"]; ok {
+		return nil, fmt.Errorf(`error in (*Client).BulkMutate: variable "input" is both an input and a variable`)
+	}
+	for i, input := range inputs {
+		if v := reflect.ValueOf(input); !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+			return nil, fmt.Errorf(`error in (*Client).BulkMutate: input %d is nil`, i)
+		}
+	}
+	name, err := operationName(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	mutations := make([]*BatchQuery, 0, len(inputs))
+	toSend := make([]*BatchQuery, 0, len(inputs))
+	clientMutationIDs := make([]map[string]string, len(inputs))
+	for i, input := range inputs {
+		vars := make(map[string]any, len(variablesByName)+1)
+		for k, v := range variablesByName {
+			vars[k] = v
+		}
+		vars["input"] = input
+		mutation := &BatchQuery{
+			Query:     reflect.New(t.Elem()).Interface(),
+			Variables: vars,
+		}
+		mutations = append(mutations, mutation)
+		if c.validateInputs {
+			if mutation.Err = validateInputs(Inputs{"input": input}); mutation.Err != nil {
+				continue
+			}
+		}
+		if c.generateClientMutationID != nil {
+			var withIDs Inputs
+			withIDs, clientMutationIDs[i], mutation.Err = setClientMutationIDs(Inputs{"input": input},
+				c.generateClientMutationID)
+			if mutation.Err != nil {
+				continue
+			}
+			vars["input"] = withIDs["input"]
+		}
+		toSend = append(toSend, mutation)
+	}
+	_ = c.doBatch(ctx, "mutation", toSend, opts)
+	results := make([]*BulkMutateResult, 0, len(mutations))
+	for i, m := range mutations {
+		result := &BulkMutateResult{
+			Mutation:         m.Query,
+			Err:              m.Err,
+			Response:         m.Response,
+			ClientMutationID: clientMutationIDs[i]["input"],
+		}
+		if ids := clientMutationIDs[i]; len(ids) > 0 {
+			if result.Err == nil {
+				if verifyErr := verifyClientMutationIDs(m.Query, ids); verifyErr != nil {
+					result.Err = &Error{
+						Err:           verifyErr,
+						Message:       verifyErr.Error(),
+						OperationName: name,
+					}
+				}
+			}
+			if gErr, ok := result.Err.(*Error); ok {
+				gErr.ClientMutationIDs = ids
+			}
+			c.logf("mutation with clientMutationIds %v: %v", ids, errOrOK(result.Err))
+		}
+		results = append(results, result)
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			return results, result.Err
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,129 @@
+package githubv4
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Client_BulkMutate(t *testing.T) {
+	type closeIssue struct {
+		CloseIssue struct {
+			Issue struct {
+				Closed bool
+			}
+		} `graphql:"closeIssue(input: $input)"`
+	}
+	t.Run("Case1", func(t *testing.T) {
+		var operations []string
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			operations = append(operations, req.Query)
+			switch len(operations) {
+			case 1:
+				assert.JSONEq(t, `{"issueId":"I0"}`, string(req.Variables["b0_input"]))
+				assert.JSONEq(t, `{"issueId":"I1"}`, string(req.Variables["b1_input"]))
+				return http.StatusOK, `{"data":{"b0_closeIssue":{"issue":{"closed":true}},"b1_closeIssue":null},` +
+					`"errors":[{"type":"FORBIDDEN","path":["b1_closeIssue"],"message":"forbidden"}]}`
+			default:
+				return http.StatusOK, `{"data":{"b2_closeIssue":{"issue":{"closed":true}}}}`
+			}
+		})
+		var times []time.Time
+		transport := c.httpClient.Transport
+		c.httpClient = &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			times = append(times, time.Now())
+			return transport.RoundTrip(req)
+		})}
+		inputs := []Input{
+			CloseIssueInput{IssueID: ID{S: "I0"}},
+			CloseIssueInput{IssueID: ID{S: "I1"}},
+			CloseIssueInput{IssueID: ID{S: "I2"}},
+		}
+		results, err := c.BulkMutate(context.Background(), &closeIssue{}, inputs, nil, &BatchOptions{
+			MaxQueries: 2,
+			Interval:   50 * time.Millisecond,
+		})
+		assert.Error(t, err)
+		assert.Equal(t, []string{
+			"mutation($b0_input:CloseIssueInput!,$b1_input:CloseIssueInput!){" +
+				"b0_closeIssue: closeIssue(input: $b0_input){issue{closed}},b1_closeIssue: closeIssue(input: $b1_input){issue{closed}}}",
+			"mutation($b2_input:CloseIssueInput!){b2_closeIssue: closeIssue(input: $b2_input){issue{closed}}}",
+		}, operations)
+		if assert.Len(t, times, 2) {
+			assert.GreaterOrEqual(t, times[1].Sub(times[0]), 50*time.Millisecond)
+		}
+		if assert.Len(t, results, 3) {
+			assert.NoError(t, results[0].Err)
+			assert.True(t, results[0].Mutation.(*closeIssue).CloseIssue.Issue.Closed)
+			assert.Same(t, results[1].Err, err)
+			assert.NoError(t, results[2].Err)
+			assert.True(t, results[2].Mutation.(*closeIssue).CloseIssue.Issue.Closed)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"b0_closeIssue":{"issue":{"closed":true}}}}`
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		// Cancel ctx after the response to the first request is received, while BulkMutate waits for the interval. The body
+		// is read before cancelling, so that cancelling does not interrupt it.
+		transport := c.httpClient.Transport
+		c.httpClient = &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				return nil, err
+			}
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			cancel()
+			return resp, nil
+		})}
+		inputs := []Input{
+			CloseIssueInput{IssueID: ID{S: "I0"}},
+			CloseIssueInput{IssueID: ID{S: "I1"}},
+		}
+		results, err := c.BulkMutate(ctx, &closeIssue{}, inputs, nil, &BatchOptions{
+			MaxQueries: 1,
+			Interval:   time.Hour,
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		if assert.Len(t, results, 2) {
+			assert.NoError(t, results[0].Err)
+			assert.ErrorIs(t, results[1].Err, context.Canceled)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		c := NewClient(nil)
+		_, err := c.BulkMutate(context.Background(), closeIssue{}, nil, nil, nil)
+		assert.ErrorContains(t, err, "non-pointer-to-struct type")
+	})
+	t.Run("Case4", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			t.Error("unexpected request")
+			return http.StatusInternalServerError, ``
+		})
+		_, err := c.BulkMutate(context.Background(), &closeIssue{}, []Input{CloseIssueInput{IssueID: ID{S: "I0"}}},
+			map[string]any{"input": CloseIssueInput{IssueID: ID{S: "I1"}}}, nil)
+		assert.ErrorContains(t, err, `variable "input" is both an input and a variable`)
+	})
+	t.Run("Case5", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			t.Error("unexpected request")
+			return http.StatusInternalServerError, ``
+		})
+		_, err := c.BulkMutate(context.Background(), &closeIssue{}, []Input{CloseIssueInput{IssueID: ID{S: "I0"}}, nil},
+			nil, nil)
+		assert.ErrorContains(t, err, "input 1 is nil")
+		_, err = c.BulkMutate(context.Background(), &closeIssue{}, []Input{(*CloseIssueInput)(nil)}, nil, nil)
+		assert.ErrorContains(t, err, "input 0 is nil")
+	})
+}
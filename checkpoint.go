@@ -0,0 +1,163 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint is the state of a pagination job, as saved by (*Client).Paginate.
+type Checkpoint struct {
+	// Cursors are the cursors of the next page, including cursors of nested connections.
+	Cursors Cursors `json:"cursors"`
+
+	// Pages is the number of pages that were processed.
+	Pages int `json:"pages"`
+}
+
+// CheckpointStore persists checkpoints of pagination jobs. Implementations must be safe for concurrent use.
+type CheckpointStore interface {
+	// Load returns the checkpoint saved under key, or nil if there is no such checkpoint.
+	Load(ctx context.Context, key string) (*Checkpoint, error)
+
+	// Save saves checkpoint under key, replacing any existing checkpoint.
+	// Implementations must not retain checkpoint after Save returns.
+	Save(ctx context.Context, key string, checkpoint *Checkpoint) error
+
+	// Delete deletes the checkpoint saved under key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryCheckpointStore is a CheckpointStore that keeps checkpoints in memory.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+var _ CheckpointStore = (*MemoryCheckpointStore)(nil)
+
+// NewMemoryCheckpointStore constructs an empty *MemoryCheckpointStore.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{
+		checkpoints: map[string]Checkpoint{},
+	}
+}
+
+// Load implements the CheckpointStore interface.
+func (m *MemoryCheckpointStore) Load(_ context.Context, key string) (*Checkpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	checkpoint, ok := m.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+	checkpoint.Cursors = copyCursors(checkpoint.Cursors)
+	return &checkpoint, nil
+}
+
+// Save implements the CheckpointStore interface.
+func (m *MemoryCheckpointStore) Save(_ context.Context, key string, checkpoint *Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkpoints[key] = Checkpoint{
+		Cursors: copyCursors(checkpoint.Cursors),
+		Pages:   checkpoint.Pages,
+	}
+	return nil
+}
+
+// Delete implements the CheckpointStore interface.
+func (m *MemoryCheckpointStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.checkpoints, key)
+	return nil
+}
+
+// FileCheckpointStore is a CheckpointStore that saves each checkpoint as a JSON file in a directory.
+// Checkpoints are written atomically by renaming a temporary file, so an interrupted Save never leaves a corrupt checkpoint.
+type FileCheckpointStore struct {
+	dir string
+}
+
+var _ CheckpointStore = (*FileCheckpointStore)(nil)
+
+// NewFileCheckpointStore constructs a *FileCheckpointStore that saves checkpoints in directory dir.
+// dir is created when the first checkpoint is saved.
+func NewFileCheckpointStore(dir string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		dir: dir,
+	}
+}
+
+func (f *FileCheckpointStore) path(key string) string {
+	return filepath.Join(f.dir, url.PathEscape(key)+".json")
+}
+
+// Load implements the CheckpointStore interface.
+func (f *FileCheckpointStore) Load(_ context.Context, key string) (*Checkpoint, error) {
+	b, err := os.ReadFile(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(b, checkpoint); err != nil {
+		return nil, fmt.Errorf(`error unmarshaling checkpoint %#v: %w`, key, err)
+	}
+	return checkpoint, nil
+}
+
+// Save implements the CheckpointStore interface.
+func (f *FileCheckpointStore) Save(_ context.Context, key string, checkpoint *Checkpoint) error {
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(f.dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(f.dir, ".checkpoint-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(b)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// Delete implements the CheckpointStore interface.
+func (f *FileCheckpointStore) Delete(_ context.Context, key string) error {
+	err := os.Remove(f.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func copyCursors(cursors Cursors) Cursors {
+	if cursors == nil {
+		return nil
+	}
+	c := make(Cursors, len(cursors))
+	for name, cursor := range cursors {
+		c[name] = cursor
+	}
+	return c
+}
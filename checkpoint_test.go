@@ -0,0 +1,46 @@
+package githubv4
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testCheckpointStore(t *testing.T, store CheckpointStore) {
+	ctx := context.Background()
+	checkpoint, err := store.Load(ctx, "org/job")
+	if assert.NoError(t, err) {
+		assert.Nil(t, checkpoint)
+	}
+	cursor := "abc"
+	saved := &Checkpoint{
+		Cursors: Cursors{"outer": &cursor, "inner": nil},
+		Pages:   3,
+	}
+	if !assert.NoError(t, store.Save(ctx, "org/job", saved)) {
+		return
+	}
+	saved.Cursors["outer"] = nil
+	checkpoint, err = store.Load(ctx, "org/job")
+	if assert.NoError(t, err) {
+		assert.Equal(t, &Checkpoint{
+			Cursors: Cursors{"outer": &cursor, "inner": nil},
+			Pages:   3,
+		}, checkpoint)
+	}
+	assert.NoError(t, store.Delete(ctx, "org/job"))
+	checkpoint, err = store.Load(ctx, "org/job")
+	if assert.NoError(t, err) {
+		assert.Nil(t, checkpoint)
+	}
+	assert.NoError(t, store.Delete(ctx, "org/job"))
+}
+
+func Test_MemoryCheckpointStore(t *testing.T) {
+	testCheckpointStore(t, NewMemoryCheckpointStore())
+}
+
+func Test_FileCheckpointStore(t *testing.T) {
+	testCheckpointStore(t, NewFileCheckpointStore(t.TempDir()))
+}
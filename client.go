@@ -1,10 +1,21 @@
 package githubv4
 
+// Generate gen.go and compat/gen.go from the schema snapshot gen/schema.json. See the gen module.
+//go:generate go run -C gen . -schema schema.json
+
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptrace"
+	"reflect"
+	"sync"
 
 	"github.com/jbrekelmans/go-graphql"
+	internalJSON "github.com/jbrekelmans/go-graphql/json"
 )
 
 type Input interface {
@@ -13,28 +24,59 @@ type Input interface {
 
 // Client is a GitHub GraphQL v4 client.
 type Client struct {
-	c *graphql.Client
+	url        string
+	httpClient *http.Client
+
+	logger                   Logger
+	generateClientMutationID func() (string, error)
+	nodeLimit                int
+	captureRateLimit         bool
+	validateInputs           bool
+
+	rateLimitMu sync.Mutex
+	rateLimit   *RateLimit
 }
 
 // NewClient constructs a client for https://api.github.com/graphql.
 // The *http.Client should add credentials/tokens to requests.
-func NewClient(httpClient *http.Client) *Client {
-	return &Client{
-		c: graphql.NewClient("https://api.github.com/graphql", httpClient),
-	}
+func NewClient(httpClient *http.Client, opts ...ClientOption) *Client {
+	return NewEnterpriseClient("https://api.github.com/graphql", httpClient, opts...)
 }
 
 // NewEnterpriseClient constructs a client for the specified GitHub GraphQL v4 endpoint.
 // The *http.Client should add credentials/tokens to requests.
-func NewEnterpriseClient(url string, httpClient *http.Client) *Client {
-	return &Client{
-		c: graphql.NewClient(url, httpClient),
+func NewEnterpriseClient(url string, httpClient *http.Client, opts ...ClientOption) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	c := &Client{
+		url:        url,
+		httpClient: httpClient,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // Query does a query operation.
 // q is a pointer to a struct that defines the GraphQL query, and also receives the response data.
 //
+// variables is either a map from variable names to values (a map[string]any or another map type with string keys), or a
+// struct (or pointer to struct) whose exported fields define the variables. The type of a variable is derived from the Go type
+// of its value. Fields of a variables struct can have a gqlvar tag with the name of the variable and optionally an explicit
+// GraphQL type, for example:
+//
+//	type variables struct {
+//		Owner string                                // Defines $owner of type String!.
+//		Name  string  `gqlvar:"repo"`              // Defines $repo of type String!.
+//		After *string `gqlvar:"after,type=String"` // Defines $after of type String.
+//		Debug bool    `gqlvar:"-"`                 // Not a variable.
+//	}
+//
+// A variables struct is verified before anything is sent: each variable referenced by q must be defined by a field, and each
+// field must be referenced by q.
+//
 // If the HTTP response status and headers were received successfully then returns a non-nil *http.Response that reflects the status and
 // headers. The body of the returned HTTP response is always closed.
 //
@@ -54,24 +96,307 @@ func NewEnterpriseClient(url string, httpClient *http.Client) *Client {
 //   - the http.RoundTripper of the *http.Client when dialing, sending the HTTP request and reading
 //     the HTTP response; -and
 //   - the underlying connnection when reading the HTTP response body.
-func (c *Client) Query(ctx context.Context, q any, variables map[string]any) (*http.Response, error) {
-	resp, err := c.c.Query(ctx, q, variables)
+func (c *Client) Query(ctx context.Context, q any, variables any) (*http.Response, error) {
+	vars, err := variablesMap(q, variables, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(ctx, "query", q, vars)
 	err = enhanceError(err)
 	return resp, err
 }
 
 // Mutate does a mutation operation.
 // m is a pointer to a struct that defines the GraphQL mutation, and also receives the response data.
+// If input is not nil then it is bound to variable "input". variables is not modified (see Query).
 //
 // See Query for more information.
-func (c *Client) Mutate(ctx context.Context, m any, input Input, variables map[string]any) (*http.Response, error) {
+func (c *Client) Mutate(ctx context.Context, m any, input Input, variables any) (*http.Response, error) {
+	var inputs Inputs
 	if input != nil {
-		if variables == nil {
-			variables = map[string]any{}
+		inputs = Inputs{"input": input}
+	}
+	return c.MutateInputs(ctx, m, inputs, variables)
+}
+
+// Inputs maps variable names to inputs of mutations.
+type Inputs map[string]Input
+
+// MutateInputs does a mutation operation with any number of inputs, each bound to the variable with the name of its key in
+// inputs. This supports mutations with arguments other than "input", and documents with several mutations. For example:
+//
+//	var m struct {
+//		AddStar struct {
+//			ClientMutationID *string
+//		} `graphql:"addStar(input: $addStar)"`
+//		AddComment struct {
+//			ClientMutationID *string
+//		} `graphql:"addComment(input: $addComment)"`
+//	}
+//	inputs := githubv4.Inputs{
+//		"addStar":    githubv4.AddStarInput{...},
+//		"addComment": githubv4.AddCommentInput{...},
+//	}
+//
+// variables (see Query) is not modified, so it can be shared between goroutines. A variable must not be in both inputs and
+// variables.
+//
+// See Query for more information.
+func (c *Client) MutateInputs(ctx context.Context, m any, inputs Inputs, variables any) (*http.Response, error) {
+	variablesByName, err := variablesMap(m, variables, inputs)
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]any, len(variablesByName)+len(inputs))
+	for k, v := range variablesByName {
+		vars[k] = v
+	}
+	for k, input := range inputs {
+		if _, ok := vars[k]; ok {
+			return nil, fmt.Errorf(`error in (*Client).MutateInputs: variable %#v is both an input and a variable`, k)
 		}
-		variables["input"] = input
+		vars[k] = input
 	}
-	resp, err := c.c.Mutate(ctx, m, variables)
+	if c.validateInputs {
+		if err := validateInputs(inputs); err != nil {
+			return nil, err
+		}
+	}
+	var clientMutationIDs map[string]string
+	if c.generateClientMutationID != nil {
+		inputs, clientMutationIDs, err = setClientMutationIDs(inputs, c.generateClientMutationID)
+		if err != nil {
+			return nil, err
+		}
+		for k, input := range inputs {
+			vars[k] = input
+		}
+	}
+	resp, err := c.do(ctx, "mutation", m, vars)
 	err = enhanceError(err)
+	if md := metadataFromContext(ctx); md != nil {
+		md.ClientMutationIDs = clientMutationIDs
+	}
+	if err == nil && len(clientMutationIDs) > 0 {
+		if verifyErr := verifyClientMutationIDs(m, clientMutationIDs); verifyErr != nil {
+			name, _ := operationName(ctx, m)
+			err = &Error{
+				Err:           verifyErr,
+				Message:       verifyErr.Error(),
+				OperationName: name,
+			}
+		}
+	}
+	if len(clientMutationIDs) > 0 {
+		if gErr, ok := err.(*Error); ok {
+			gErr.ClientMutationIDs = clientMutationIDs
+		}
+		c.logf("mutation with clientMutationIds %v: %v", clientMutationIDs, errOrOK(err))
+	}
 	return resp, err
 }
+
+// logf logs if the *Client was constructed with WithLogger.
+func (c *Client) logf(format string, v ...any) {
+	if c.logger != nil {
+		c.logger.Printf("githubv4: "+format, v...)
+	}
+}
+
+// errOrOK returns err, or "ok" if err is nil. Useful for logging.
+func errOrOK(err error) any {
+	if err == nil {
+		return "ok"
+	}
+	return err
+}
+
+// Exec sends a GraphQL document as is. This is useful for hand-written documents with fragments, directives or aliases that
+// are awkward to express as Go structs.
+// operationName selects the operation to execute if document has several operations, and may be empty otherwise.
+// out is a pointer that receives the response data. If out points to a struct then the data is decoded like Query does
+// (i.e. inline fragments are supported), otherwise the data is decoded using encoding/json. So out can also point to a map or a
+// json.RawMessage.
+//
+// See Query for more information on errors and responses.
+func (c *Client) Exec(ctx context.Context, document string, operationName string, variables map[string]any, out any) (
+	*http.Response, error) {
+	if md := metadataFromContext(ctx); md != nil {
+		*md = Metadata{
+			OperationName: operationName,
+		}
+	}
+	resp, errItems, err := c.send(ctx, request{
+		Query:         document,
+		Variables:     variables,
+		OperationName: operationName,
+	}, func(data []byte) error {
+		if isStructPointer(out) {
+			return internalJSON.Unmarshal(data, out)
+		}
+		return json.Unmarshal(data, out)
+	})
+	if err != nil {
+		return resp, setOperationName(enhanceError(&graphql.Error{
+			Err:       err,
+			Errors:    errItems,
+			Message:   err.Error(),
+			Operation: document,
+		}), operationName)
+	}
+	return resp, nil
+}
+
+// isStructPointer returns true if v is a pointer to a struct (possibly through several pointers) that does not implement
+// json.Unmarshaler.
+func isStructPointer(v any) bool {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || t.Implements(jsonUnmarshalerType) {
+		return false
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	OperationName string         `json:"operationName,omitempty"`
+
+	// isQuery is true if Query is a single query operation constructed by this package, so that the rateLimit field can be
+	// added to it (see WithRateLimitCapture).
+	isQuery bool
+
+	// onSend, if not nil, is called when the request is sent: right before it is passed to the *http.Client, and again when
+	// the transport reports that the request was written (see httptrace.ClientTrace).
+	onSend func()
+}
+
+type response struct {
+	Data   *json.RawMessage    `json:"data"`
+	Errors []graphql.ErrorItem `json:"errors"`
+}
+
+// do constructs an operation from q and variables, sends the operation and decodes the response data into q.
+// Sets the *Metadata of ctx (see WithMetadata).
+// The returned error is either an error constructing the operation or an *Error.
+func (c *Client) do(ctx context.Context, operationType string, q any, variables map[string]any) (*http.Response, error) {
+	name, err := operationName(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if md := metadataFromContext(ctx); md != nil {
+		*md = Metadata{
+			OperationName: name,
+		}
+	}
+	operation, err := constructOperation(operationType, name, q, variables)
+	if err != nil {
+		return nil, err
+	}
+	if c.nodeLimit > 0 {
+		// Operations whose node count cannot be determined are sent, GitHub checks them.
+		if analysis, err := AnalyzeCost(q, variables); err == nil && analysis.NodeCount > c.nodeLimit {
+			return nil, &NodeLimitError{
+				NodeCount: analysis.NodeCount,
+				Limit:     c.nodeLimit,
+			}
+		}
+	}
+	resp, errItems, err := c.send(ctx, request{
+		Query:         operation,
+		Variables:     variables,
+		OperationName: name,
+		isQuery:       operationType == "query",
+	}, func(data []byte) error {
+		return internalJSON.Unmarshal(data, q)
+	})
+	if err != nil {
+		return resp, setOperationName(enhanceError(&graphql.Error{
+			Err:       err,
+			Errors:    errItems,
+			Message:   err.Error(),
+			Operation: operation,
+		}), name)
+	}
+	return resp, nil
+}
+
+// send sends req and passes the "data" property of the response to decode.
+// If the response has errors then returns the errors of the response and a non-nil error.
+// If the *Client was constructed with WithRateLimitCapture and req is a query then the rateLimit field is added to the query,
+// and removed from the data before it is passed to decode.
+func (c *Client) send(ctx context.Context, req request, decode func(data []byte) error) (
+	resp *http.Response, errItems []graphql.ErrorItem, err error) {
+	if c.captureRateLimit && req.isQuery {
+		req.Query = injectRateLimit(req.Query)
+		decodeData := decode
+		decode = func(data []byte) error {
+			data, rateLimit, err := extractRateLimit(data)
+			if err != nil {
+				return err
+			}
+			if rateLimit != nil {
+				c.setRateLimit(rateLimit)
+				if md := metadataFromContext(ctx); md != nil {
+					md.RateLimit = rateLimit
+				}
+			}
+			return decodeData(data)
+		}
+	}
+	req.Variables = jsonVariables(req.Variables)
+	var reqBody bytes.Buffer
+	if err = json.NewEncoder(&reqBody).Encode(req); err != nil {
+		return
+	}
+	if req.onSend != nil {
+		ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+			WroteRequest: func(httptrace.WroteRequestInfo) {
+				req.onSend()
+			},
+		})
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, &reqBody)
+	if err != nil {
+		return
+	}
+	httpReq.Header.Add("Content-Type", "application/json")
+	if req.onSend != nil {
+		req.onSend()
+	}
+	resp, err = c.httpClient.Do(httpReq)
+	if err != nil {
+		return
+	}
+	respBodyBytes, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		err = fmt.Errorf(`error reading body of %d-response: %w`, resp.StatusCode, err)
+		return
+	}
+	var respBody response
+	if err = json.NewDecoder(bytes.NewReader(respBodyBytes)).Decode(&respBody); err != nil {
+		err = fmt.Errorf(`error unmarshaling body of %d-response: %s (%w)`, resp.StatusCode,
+			string(respBodyBytes), err)
+		return
+	}
+	errItems = respBody.Errors
+	if resp.StatusCode != http.StatusOK {
+		err = fmt.Errorf(`response has non-success status %d: %s`, resp.StatusCode, string(respBodyBytes))
+		return
+	}
+	if respBody.Data != nil {
+		if decodeErr := decode(*respBody.Data); decodeErr != nil {
+			err = fmt.Errorf(`error decoding data of %d-response: %w`, resp.StatusCode, decodeErr)
+			return
+		}
+	}
+	if len(respBody.Errors) > 0 {
+		errorsJSON, _ := json.Marshal(respBody.Errors)
+		err = fmt.Errorf(`%d-response with errors: %s`, resp.StatusCode, string(errorsJSON))
+	}
+	return
+}
@@ -0,0 +1,208 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRequest struct {
+	Query         string                     `json:"query"`
+	Variables     map[string]json.RawMessage `json:"variables"`
+	OperationName string                     `json:"operationName"`
+}
+
+// roundTripperFunc implements http.RoundTripper.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestClient constructs a *Client that sends requests to a test server.
+// handler receives each request and returns the status code and body of the response.
+func newTestClient(t *testing.T, handler func(req testRequest) (int, string), opts ...ClientOption) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var req testRequest
+		if !assert.NoError(t, json.Unmarshal(b, &req)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		statusCode, respBody := handler(req)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = io.WriteString(w, respBody)
+	}))
+	t.Cleanup(server.Close)
+	return NewEnterpriseClient(server.URL, server.Client(), opts...)
+}
+
+func Test_Client(t *testing.T) {
+	t.Run("Query", func(t *testing.T) {
+		t.Run("Case1", func(t *testing.T) {
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				assert.Equal(t, "query{viewer{login}}", req.Query)
+				return http.StatusOK, `{"data":{"viewer":{"login":"gopher"}}}`
+			})
+			var q struct {
+				Viewer struct {
+					Login string
+				}
+			}
+			resp, err := c.Query(context.Background(), &q, nil)
+			if assert.NoError(t, err) {
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				assert.Equal(t, "gopher", q.Viewer.Login)
+			}
+		})
+		t.Run("Case2", func(t *testing.T) {
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				return http.StatusOK, `{"data":null,"errors":[{"message":"m","type":"RATE_LIMITED"}]}`
+			})
+			var q struct {
+				Viewer struct {
+					Login string
+				}
+			}
+			_, err := c.Query(context.Background(), &q, nil)
+			if assert.IsType(t, &Error{}, err) {
+				errItems := err.(*Error).Errors
+				if assert.Len(t, errItems, 1) {
+					assert.Equal(t, "RATE_LIMITED", errItems[0].Type)
+				}
+			}
+		})
+	})
+	t.Run("Mutate", func(t *testing.T) {
+		t.Run("Case1", func(t *testing.T) {
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				assert.Equal(t, "mutation($input:AddStarInput!){addStar(input: $input){clientMutationId}}", req.Query)
+				assert.JSONEq(t, `{"starrableId":"id1"}`, string(req.Variables["input"]))
+				return http.StatusOK, `{"data":{"addStar":{"clientMutationId":null}}}`
+			})
+			var m struct {
+				AddStar struct {
+					ClientMutationID *string
+				} `graphql:"addStar(input: $input)"`
+			}
+			_, err := c.Mutate(context.Background(), &m, AddStarInput{StarrableID: ID{S: "id1"}}, nil)
+			assert.NoError(t, err)
+		})
+		t.Run("Case2", func(t *testing.T) {
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				return http.StatusOK, `{"data":{"addStar":{"clientMutationId":null}}}`
+			})
+			var m struct {
+				AddStar struct {
+					ClientMutationID *string
+				} `graphql:"addStar(input: $input)"`
+			}
+			variables := map[string]any{}
+			_, err := c.Mutate(context.Background(), &m, AddStarInput{StarrableID: ID{S: "id1"}}, variables)
+			if assert.NoError(t, err) {
+				assert.Empty(t, variables)
+			}
+		})
+	})
+	t.Run("MutateInputs", func(t *testing.T) {
+		t.Run("Case1", func(t *testing.T) {
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				assert.Equal(t, "mutation($addStar:AddStarInput!,$removeStar:RemoveStarInput!){"+
+					"addStar(input: $addStar){clientMutationId}removeStar(input: $removeStar){clientMutationId}}", req.Query)
+				assert.JSONEq(t, `{"starrableId":"id1"}`, string(req.Variables["addStar"]))
+				assert.JSONEq(t, `{"starrableId":"id2"}`, string(req.Variables["removeStar"]))
+				return http.StatusOK, `{"data":{"addStar":{"clientMutationId":"a"},"removeStar":{"clientMutationId":"r"}}}`
+			})
+			var m struct {
+				AddStar struct {
+					ClientMutationID *string
+				} `graphql:"addStar(input: $addStar)"`
+				RemoveStar struct {
+					ClientMutationID *string
+				} `graphql:"removeStar(input: $removeStar)"`
+			}
+			_, err := c.MutateInputs(context.Background(), &m, Inputs{
+				"addStar":    AddStarInput{StarrableID: ID{S: "id1"}},
+				"removeStar": RemoveStarInput{StarrableID: ID{S: "id2"}},
+			}, nil)
+			if assert.NoError(t, err) && assert.NotNil(t, m.RemoveStar.ClientMutationID) {
+				assert.Equal(t, "r", *m.RemoveStar.ClientMutationID)
+			}
+		})
+		t.Run("Case2", func(t *testing.T) {
+			c := NewClient(nil)
+			var m struct {
+				AddStar struct {
+					ClientMutationID *string
+				} `graphql:"addStar(input: $input)"`
+			}
+			_, err := c.MutateInputs(context.Background(), &m, Inputs{
+				"input": AddStarInput{},
+			}, map[string]any{
+				"input": 1,
+			})
+			assert.ErrorContains(t, err, "is both an input and a variable")
+		})
+	})
+	t.Run("Exec", func(t *testing.T) {
+		document := `query Viewer($size: Int!) { viewer { ...user } } fragment user on User { login avatar: avatarUrl(size: $size) }`
+		newClient := func(t *testing.T) *Client {
+			return newTestClient(t, func(req testRequest) (int, string) {
+				assert.Equal(t, document, req.Query)
+				assert.Equal(t, "Viewer", req.OperationName)
+				assert.JSONEq(t, `72`, string(req.Variables["size"]))
+				return http.StatusOK, `{"data":{"viewer":{"login":"gopher","avatar":"https://a"}}}`
+			})
+		}
+		variables := map[string]any{"size": 72}
+		t.Run("Case1", func(t *testing.T) {
+			var out struct {
+				Viewer struct {
+					Login  string
+					Avatar string
+				}
+			}
+			_, err := newClient(t).Exec(context.Background(), document, "Viewer", variables, &out)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "gopher", out.Viewer.Login)
+				assert.Equal(t, "https://a", out.Viewer.Avatar)
+			}
+		})
+		t.Run("Case2", func(t *testing.T) {
+			var out map[string]any
+			_, err := newClient(t).Exec(context.Background(), document, "Viewer", variables, &out)
+			if assert.NoError(t, err) {
+				assert.Equal(t, map[string]any{"viewer": map[string]any{"login": "gopher", "avatar": "https://a"}}, out)
+			}
+		})
+		t.Run("Case3", func(t *testing.T) {
+			var out json.RawMessage
+			_, err := newClient(t).Exec(context.Background(), document, "Viewer", variables, &out)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{"viewer":{"login":"gopher","avatar":"https://a"}}`, string(out))
+			}
+		})
+		t.Run("Case4", func(t *testing.T) {
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				return http.StatusOK, `{"data":null,"errors":[{"message":"m","type":"RATE_LIMITED"}]}`
+			})
+			var out json.RawMessage
+			_, err := c.Exec(context.Background(), "{viewer{login}}", "", nil, &out)
+			if assert.IsType(t, &Error{}, err) {
+				assert.Equal(t, "{viewer{login}}", err.(*Error).Operation)
+				assert.Equal(t, "RATE_LIMITED", err.(*Error).Errors[0].Type)
+			}
+		})
+	})
+}
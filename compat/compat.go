@@ -0,0 +1,96 @@
+// Package compat provides an API that is compatible with github.com/shurcooL/githubv4, backed by the *githubv4.Client of
+// github.com/jbrekelmans/go-githubv4. It supports migrating one package at a time:
+//
+//  1. Replace import "github.com/shurcooL/githubv4" by import githubv4 "github.com/jbrekelmans/go-githubv4/compat". Code
+//     that uses the client, scalars and enums keeps compiling. Code that constructs input objects typically does not, see
+//     below.
+//  2. Run the analyzer of module github.com/jbrekelmans/go-githubv4/migrate, which rewrites call sites, scalar usages and
+//     literals of input objects to the native API and reports what it cannot rewrite.
+//
+// Input objects and enums are aliases of the native types, so fields of input objects have the Go types of the native
+// package: string instead of githubv4.String, githubv4.Optional[T] instead of *T, and the struct githubv4.ID instead of
+// the interface ID. So literals of input objects that were written against github.com/shurcooL/githubv4, like
+// githubv4.AddCommentInput{SubjectID: id, Body: githubv4.String(body)}, must be rewritten (by the analyzer or by hand).
+//
+// Deprecated input objects, enums and enum values are not aliased. Use the native package for them.
+package compat
+
+import (
+	"context"
+	"net/http"
+
+	githubv4 "github.com/jbrekelmans/go-githubv4"
+)
+
+// Client is a GitHub GraphQL API v4 client with the API of github.com/shurcooL/githubv4.
+type Client struct {
+	client *githubv4.Client
+}
+
+// NewClient creates a new GitHub GraphQL API v4 client with the provided http.Client.
+// If httpClient is nil, then http.DefaultClient is used.
+//
+// Note that GitHub GraphQL API v4 requires authentication, so
+// the provided http.Client is expected to take care of that.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		client: githubv4.NewClient(httpClient),
+	}
+}
+
+// NewEnterpriseClient creates a new GitHub GraphQL API v4 client for the GitHub Enterprise
+// instance with the specified GraphQL endpoint URL, using the provided http.Client.
+// If httpClient is nil, then http.DefaultClient is used.
+//
+// Note that GitHub GraphQL API v4 requires authentication, so
+// the provided http.Client is expected to take care of that.
+func NewEnterpriseClient(url string, httpClient *http.Client) *Client {
+	return &Client{
+		client: githubv4.NewEnterpriseClient(url, httpClient),
+	}
+}
+
+// Wrap returns a *Client backed by client. This allows constructing the client with options of the native package.
+func Wrap(client *githubv4.Client) *Client {
+	return &Client{
+		client: client,
+	}
+}
+
+// Unwrap returns the native client that backs c.
+func (c *Client) Unwrap() *githubv4.Client {
+	return c.client
+}
+
+// Query executes a single GraphQL query request,
+// with a query derived from q, populating the response into it.
+// q should be a pointer to struct that corresponds to the GitHub GraphQL schema.
+func (c *Client) Query(ctx context.Context, q any, variables map[string]any) error {
+	_, err := c.client.Query(ctx, q, convertVariables(variables))
+	return err
+}
+
+// Input represents one of the Input structs:
+//
+// AcceptEnterpriseAdministratorInvitationInput, AcceptTopicSuggestionInput, AddAssigneesToAssignableInput, AddCommentInput,
+// AddLabelsToLabelableInput, AddProjectCardInput, AddProjectColumnInput, AddPullRequestReviewCommentInput, AddReactionInput,
+// AddStarInput, ... and so on.
+type Input any
+
+// Mutate executes a single GraphQL mutation request,
+// with a mutation derived from m, populating the response into it.
+// m should be a pointer to struct that corresponds to the GitHub GraphQL schema.
+// Provided input will be set as a variable named "input".
+func (c *Client) Mutate(ctx context.Context, m any, input Input, variables map[string]any) error {
+	vars := make(map[string]any, len(variables)+1)
+	for k, v := range convertVariables(variables) {
+		vars[k] = v
+	}
+	if nativeInput, ok := input.(githubv4.Input); ok {
+		_, err := c.client.Mutate(ctx, m, nativeInput, vars)
+		return err
+	}
+	vars["input"] = convertVariable(input)
+	_, err := c.client.Mutate(ctx, m, nil, vars)
+	return err
+}
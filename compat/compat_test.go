@@ -0,0 +1,110 @@
+package compat
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRequest struct {
+	Query     string                     `json:"query"`
+	Variables map[string]json.RawMessage `json:"variables"`
+}
+
+// newTestClient constructs a *Client that sends requests to a test server.
+// handler receives each request and returns the status code and body of the response.
+func newTestClient(t *testing.T, handler func(req testRequest) (int, string)) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var req testRequest
+		if !assert.NoError(t, json.Unmarshal(b, &req)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		statusCode, respBody := handler(req)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = io.WriteString(w, respBody)
+	}))
+	t.Cleanup(server.Close)
+	return NewEnterpriseClient(server.URL, server.Client())
+}
+
+func Test_Client(t *testing.T) {
+	t.Run("Query", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query($ids:[ID!]!,$since:GitTimestamp,$uri:URI!){nodes(ids: $ids){id}"+
+				"resource(url: $uri){resourcePath}commits(since: $since){committedDate}}", req.Query)
+			assert.JSONEq(t, `["a","b"]`, string(req.Variables["ids"]))
+			assert.JSONEq(t, `"https://github.com/octocat"`, string(req.Variables["uri"]))
+			assert.JSONEq(t, `"2026-10-18T12:00:00Z"`, string(req.Variables["since"]))
+			return 200, `{"data":{"nodes":[{"id":"a"}],"resource":{"resourcePath":"/octocat"},` +
+				`"commits":{"committedDate":"2026-10-18"}}}`
+		})
+		var q struct {
+			Nodes []struct {
+				ID ID
+			} `graphql:"nodes(ids: $ids)"`
+			Resource struct {
+				ResourcePath URI
+			} `graphql:"resource(url: $uri)"`
+			Commits struct {
+				CommittedDate Date
+			} `graphql:"commits(since: $since)"`
+		}
+		u, _ := url.Parse("https://github.com/octocat")
+		err := c.Query(context.Background(), &q, map[string]any{
+			"ids":   []ID{"a", "b"},
+			"uri":   URI{URL: u},
+			"since": NewGitTimestamp(GitTimestamp{time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}),
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, ID("a"), q.Nodes[0].ID)
+			assert.Equal(t, "/octocat", q.Resource.ResourcePath.Path)
+			assert.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), q.Commits.CommittedDate.Time)
+		}
+	})
+	t.Run("Mutate", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "mutation($input:AddStarInput!){addStar(input: $input){clientMutationId}}", req.Query)
+			assert.JSONEq(t, `{"starrableId":"a"}`, string(req.Variables["input"]))
+			return 200, `{"data":{"addStar":{"clientMutationId":null}}}`
+		})
+		var m struct {
+			AddStar struct {
+				ClientMutationID *String
+			} `graphql:"addStar(input: $input)"`
+		}
+		input := AddStarInput{}
+		input.StarrableID.S = "a"
+		err := c.Mutate(context.Background(), &m, input, nil)
+		assert.NoError(t, err)
+	})
+}
+
+func Test_convertVariables(t *testing.T) {
+	assert.Nil(t, convertVariables(nil))
+	b, err := json.Marshal(convertVariables(map[string]any{
+		"base64": Base64String("aGVsbG8="),
+		"html":   NewHTML("<p>"),
+		"nilOID": (*GitObjectID)(nil),
+		"date":   Date{time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		"id":     NewID("a"),
+		"string": String("x"),
+	}))
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"base64":"aGVsbG8=","html":"<p>","nilOID":null,"date":"2026-10-18","id":"a","string":"x"}`,
+			string(b))
+	}
+}
@@ -0,0 +1,3313 @@
+package compat
+
+import githubv4 "github.com/jbrekelmans/go-githubv4"
+
+// AbortQueuedMigrationsInput is an alias of githubv4.AbortQueuedMigrationsInput.
+type AbortQueuedMigrationsInput = githubv4.AbortQueuedMigrationsInput
+
+// AcceptEnterpriseAdministratorInvitationInput is an alias of githubv4.AcceptEnterpriseAdministratorInvitationInput.
+type AcceptEnterpriseAdministratorInvitationInput = githubv4.AcceptEnterpriseAdministratorInvitationInput
+
+// AcceptTopicSuggestionInput is an alias of githubv4.AcceptTopicSuggestionInput.
+type AcceptTopicSuggestionInput = githubv4.AcceptTopicSuggestionInput
+
+// ActorType is an alias of githubv4.ActorType.
+type ActorType = githubv4.ActorType
+
+const (
+	ActorTypeUser = githubv4.ActorTypeUser
+	ActorTypeTeam = githubv4.ActorTypeTeam
+)
+
+// AddAssigneesToAssignableInput is an alias of githubv4.AddAssigneesToAssignableInput.
+type AddAssigneesToAssignableInput = githubv4.AddAssigneesToAssignableInput
+
+// AddCommentInput is an alias of githubv4.AddCommentInput.
+type AddCommentInput = githubv4.AddCommentInput
+
+// AddDiscussionCommentInput is an alias of githubv4.AddDiscussionCommentInput.
+type AddDiscussionCommentInput = githubv4.AddDiscussionCommentInput
+
+// AddDiscussionPollVoteInput is an alias of githubv4.AddDiscussionPollVoteInput.
+type AddDiscussionPollVoteInput = githubv4.AddDiscussionPollVoteInput
+
+// AddEnterpriseOrganizationMemberInput is an alias of githubv4.AddEnterpriseOrganizationMemberInput.
+type AddEnterpriseOrganizationMemberInput = githubv4.AddEnterpriseOrganizationMemberInput
+
+// AddEnterpriseSupportEntitlementInput is an alias of githubv4.AddEnterpriseSupportEntitlementInput.
+type AddEnterpriseSupportEntitlementInput = githubv4.AddEnterpriseSupportEntitlementInput
+
+// AddLabelsToLabelableInput is an alias of githubv4.AddLabelsToLabelableInput.
+type AddLabelsToLabelableInput = githubv4.AddLabelsToLabelableInput
+
+// AddProjectCardInput is an alias of githubv4.AddProjectCardInput.
+type AddProjectCardInput = githubv4.AddProjectCardInput
+
+// AddProjectColumnInput is an alias of githubv4.AddProjectColumnInput.
+type AddProjectColumnInput = githubv4.AddProjectColumnInput
+
+// AddProjectV2DraftIssueInput is an alias of githubv4.AddProjectV2DraftIssueInput.
+type AddProjectV2DraftIssueInput = githubv4.AddProjectV2DraftIssueInput
+
+// AddProjectV2ItemByIDInput is an alias of githubv4.AddProjectV2ItemByIDInput.
+type AddProjectV2ItemByIDInput = githubv4.AddProjectV2ItemByIDInput
+
+// AddPullRequestReviewInput is an alias of githubv4.AddPullRequestReviewInput.
+type AddPullRequestReviewInput = githubv4.AddPullRequestReviewInput
+
+// AddPullRequestReviewThreadInput is an alias of githubv4.AddPullRequestReviewThreadInput.
+type AddPullRequestReviewThreadInput = githubv4.AddPullRequestReviewThreadInput
+
+// AddPullRequestReviewThreadReplyInput is an alias of githubv4.AddPullRequestReviewThreadReplyInput.
+type AddPullRequestReviewThreadReplyInput = githubv4.AddPullRequestReviewThreadReplyInput
+
+// AddReactionInput is an alias of githubv4.AddReactionInput.
+type AddReactionInput = githubv4.AddReactionInput
+
+// AddStarInput is an alias of githubv4.AddStarInput.
+type AddStarInput = githubv4.AddStarInput
+
+// AddUpvoteInput is an alias of githubv4.AddUpvoteInput.
+type AddUpvoteInput = githubv4.AddUpvoteInput
+
+// AddVerifiableDomainInput is an alias of githubv4.AddVerifiableDomainInput.
+type AddVerifiableDomainInput = githubv4.AddVerifiableDomainInput
+
+// ApproveDeploymentsInput is an alias of githubv4.ApproveDeploymentsInput.
+type ApproveDeploymentsInput = githubv4.ApproveDeploymentsInput
+
+// ApproveVerifiableDomainInput is an alias of githubv4.ApproveVerifiableDomainInput.
+type ApproveVerifiableDomainInput = githubv4.ApproveVerifiableDomainInput
+
+// ArchiveProjectV2ItemInput is an alias of githubv4.ArchiveProjectV2ItemInput.
+type ArchiveProjectV2ItemInput = githubv4.ArchiveProjectV2ItemInput
+
+// ArchiveRepositoryInput is an alias of githubv4.ArchiveRepositoryInput.
+type ArchiveRepositoryInput = githubv4.ArchiveRepositoryInput
+
+// AuditLogOrder is an alias of githubv4.AuditLogOrder.
+type AuditLogOrder = githubv4.AuditLogOrder
+
+// AuditLogOrderField is an alias of githubv4.AuditLogOrderField.
+type AuditLogOrderField = githubv4.AuditLogOrderField
+
+const (
+	AuditLogOrderFieldCreatedAt = githubv4.AuditLogOrderFieldCreatedAt
+)
+
+// BranchNamePatternParametersInput is an alias of githubv4.BranchNamePatternParametersInput.
+type BranchNamePatternParametersInput = githubv4.BranchNamePatternParametersInput
+
+// BulkSponsorship is an alias of githubv4.BulkSponsorship.
+type BulkSponsorship = githubv4.BulkSponsorship
+
+// CancelEnterpriseAdminInvitationInput is an alias of githubv4.CancelEnterpriseAdminInvitationInput.
+type CancelEnterpriseAdminInvitationInput = githubv4.CancelEnterpriseAdminInvitationInput
+
+// CancelSponsorshipInput is an alias of githubv4.CancelSponsorshipInput.
+type CancelSponsorshipInput = githubv4.CancelSponsorshipInput
+
+// ChangeUserStatusInput is an alias of githubv4.ChangeUserStatusInput.
+type ChangeUserStatusInput = githubv4.ChangeUserStatusInput
+
+// CheckAnnotationData is an alias of githubv4.CheckAnnotationData.
+type CheckAnnotationData = githubv4.CheckAnnotationData
+
+// CheckAnnotationLevel is an alias of githubv4.CheckAnnotationLevel.
+type CheckAnnotationLevel = githubv4.CheckAnnotationLevel
+
+const (
+	CheckAnnotationLevelFailure = githubv4.CheckAnnotationLevelFailure
+	CheckAnnotationLevelNotice  = githubv4.CheckAnnotationLevelNotice
+	CheckAnnotationLevelWarning = githubv4.CheckAnnotationLevelWarning
+)
+
+// CheckAnnotationRange is an alias of githubv4.CheckAnnotationRange.
+type CheckAnnotationRange = githubv4.CheckAnnotationRange
+
+// CheckConclusionState is an alias of githubv4.CheckConclusionState.
+type CheckConclusionState = githubv4.CheckConclusionState
+
+const (
+	CheckConclusionStateActionRequired = githubv4.CheckConclusionStateActionRequired
+	CheckConclusionStateTimedOut       = githubv4.CheckConclusionStateTimedOut
+	CheckConclusionStateCancelled      = githubv4.CheckConclusionStateCancelled
+	CheckConclusionStateFailure        = githubv4.CheckConclusionStateFailure
+	CheckConclusionStateSuccess        = githubv4.CheckConclusionStateSuccess
+	CheckConclusionStateNeutral        = githubv4.CheckConclusionStateNeutral
+	CheckConclusionStateSkipped        = githubv4.CheckConclusionStateSkipped
+	CheckConclusionStateStartupFailure = githubv4.CheckConclusionStateStartupFailure
+	CheckConclusionStateStale          = githubv4.CheckConclusionStateStale
+)
+
+// CheckRunAction is an alias of githubv4.CheckRunAction.
+type CheckRunAction = githubv4.CheckRunAction
+
+// CheckRunFilter is an alias of githubv4.CheckRunFilter.
+type CheckRunFilter = githubv4.CheckRunFilter
+
+// CheckRunOutput is an alias of githubv4.CheckRunOutput.
+type CheckRunOutput = githubv4.CheckRunOutput
+
+// CheckRunOutputImage is an alias of githubv4.CheckRunOutputImage.
+type CheckRunOutputImage = githubv4.CheckRunOutputImage
+
+// CheckRunState is an alias of githubv4.CheckRunState.
+type CheckRunState = githubv4.CheckRunState
+
+const (
+	CheckRunStateActionRequired = githubv4.CheckRunStateActionRequired
+	CheckRunStateCancelled      = githubv4.CheckRunStateCancelled
+	CheckRunStateCompleted      = githubv4.CheckRunStateCompleted
+	CheckRunStateFailure        = githubv4.CheckRunStateFailure
+	CheckRunStateInProgress     = githubv4.CheckRunStateInProgress
+	CheckRunStateNeutral        = githubv4.CheckRunStateNeutral
+	CheckRunStatePending        = githubv4.CheckRunStatePending
+	CheckRunStateQueued         = githubv4.CheckRunStateQueued
+	CheckRunStateSkipped        = githubv4.CheckRunStateSkipped
+	CheckRunStateStale          = githubv4.CheckRunStateStale
+	CheckRunStateStartupFailure = githubv4.CheckRunStateStartupFailure
+	CheckRunStateSuccess        = githubv4.CheckRunStateSuccess
+	CheckRunStateTimedOut       = githubv4.CheckRunStateTimedOut
+	CheckRunStateWaiting        = githubv4.CheckRunStateWaiting
+)
+
+// CheckRunType is an alias of githubv4.CheckRunType.
+type CheckRunType = githubv4.CheckRunType
+
+const (
+	CheckRunTypeAll    = githubv4.CheckRunTypeAll
+	CheckRunTypeLatest = githubv4.CheckRunTypeLatest
+)
+
+// CheckStatusState is an alias of githubv4.CheckStatusState.
+type CheckStatusState = githubv4.CheckStatusState
+
+const (
+	CheckStatusStateQueued     = githubv4.CheckStatusStateQueued
+	CheckStatusStateInProgress = githubv4.CheckStatusStateInProgress
+	CheckStatusStateCompleted  = githubv4.CheckStatusStateCompleted
+	CheckStatusStateWaiting    = githubv4.CheckStatusStateWaiting
+	CheckStatusStatePending    = githubv4.CheckStatusStatePending
+	CheckStatusStateRequested  = githubv4.CheckStatusStateRequested
+)
+
+// CheckSuiteAutoTriggerPreference is an alias of githubv4.CheckSuiteAutoTriggerPreference.
+type CheckSuiteAutoTriggerPreference = githubv4.CheckSuiteAutoTriggerPreference
+
+// CheckSuiteFilter is an alias of githubv4.CheckSuiteFilter.
+type CheckSuiteFilter = githubv4.CheckSuiteFilter
+
+// ClearLabelsFromLabelableInput is an alias of githubv4.ClearLabelsFromLabelableInput.
+type ClearLabelsFromLabelableInput = githubv4.ClearLabelsFromLabelableInput
+
+// ClearProjectV2ItemFieldValueInput is an alias of githubv4.ClearProjectV2ItemFieldValueInput.
+type ClearProjectV2ItemFieldValueInput = githubv4.ClearProjectV2ItemFieldValueInput
+
+// CloneProjectInput is an alias of githubv4.CloneProjectInput.
+type CloneProjectInput = githubv4.CloneProjectInput
+
+// CloneTemplateRepositoryInput is an alias of githubv4.CloneTemplateRepositoryInput.
+type CloneTemplateRepositoryInput = githubv4.CloneTemplateRepositoryInput
+
+// CloseDiscussionInput is an alias of githubv4.CloseDiscussionInput.
+type CloseDiscussionInput = githubv4.CloseDiscussionInput
+
+// CloseIssueInput is an alias of githubv4.CloseIssueInput.
+type CloseIssueInput = githubv4.CloseIssueInput
+
+// ClosePullRequestInput is an alias of githubv4.ClosePullRequestInput.
+type ClosePullRequestInput = githubv4.ClosePullRequestInput
+
+// CollaboratorAffiliation is an alias of githubv4.CollaboratorAffiliation.
+type CollaboratorAffiliation = githubv4.CollaboratorAffiliation
+
+const (
+	CollaboratorAffiliationOutside = githubv4.CollaboratorAffiliationOutside
+	CollaboratorAffiliationDirect  = githubv4.CollaboratorAffiliationDirect
+	CollaboratorAffiliationAll     = githubv4.CollaboratorAffiliationAll
+)
+
+// CommentAuthorAssociation is an alias of githubv4.CommentAuthorAssociation.
+type CommentAuthorAssociation = githubv4.CommentAuthorAssociation
+
+const (
+	CommentAuthorAssociationMember               = githubv4.CommentAuthorAssociationMember
+	CommentAuthorAssociationOwner                = githubv4.CommentAuthorAssociationOwner
+	CommentAuthorAssociationMannequin            = githubv4.CommentAuthorAssociationMannequin
+	CommentAuthorAssociationCollaborator         = githubv4.CommentAuthorAssociationCollaborator
+	CommentAuthorAssociationContributor          = githubv4.CommentAuthorAssociationContributor
+	CommentAuthorAssociationFirstTimeContributor = githubv4.CommentAuthorAssociationFirstTimeContributor
+	CommentAuthorAssociationFirstTimer           = githubv4.CommentAuthorAssociationFirstTimer
+	CommentAuthorAssociationNone                 = githubv4.CommentAuthorAssociationNone
+)
+
+// CommentCannotUpdateReason is an alias of githubv4.CommentCannotUpdateReason.
+type CommentCannotUpdateReason = githubv4.CommentCannotUpdateReason
+
+const (
+	CommentCannotUpdateReasonArchived              = githubv4.CommentCannotUpdateReasonArchived
+	CommentCannotUpdateReasonInsufficientAccess    = githubv4.CommentCannotUpdateReasonInsufficientAccess
+	CommentCannotUpdateReasonLocked                = githubv4.CommentCannotUpdateReasonLocked
+	CommentCannotUpdateReasonLoginRequired         = githubv4.CommentCannotUpdateReasonLoginRequired
+	CommentCannotUpdateReasonMaintenance           = githubv4.CommentCannotUpdateReasonMaintenance
+	CommentCannotUpdateReasonVerifiedEmailRequired = githubv4.CommentCannotUpdateReasonVerifiedEmailRequired
+	CommentCannotUpdateReasonDenied                = githubv4.CommentCannotUpdateReasonDenied
+)
+
+// CommitAuthor is an alias of githubv4.CommitAuthor.
+type CommitAuthor = githubv4.CommitAuthor
+
+// CommitAuthorEmailPatternParametersInput is an alias of githubv4.CommitAuthorEmailPatternParametersInput.
+type CommitAuthorEmailPatternParametersInput = githubv4.CommitAuthorEmailPatternParametersInput
+
+// CommitContributionOrder is an alias of githubv4.CommitContributionOrder.
+type CommitContributionOrder = githubv4.CommitContributionOrder
+
+// CommitContributionOrderField is an alias of githubv4.CommitContributionOrderField.
+type CommitContributionOrderField = githubv4.CommitContributionOrderField
+
+const (
+	CommitContributionOrderFieldOccurredAt  = githubv4.CommitContributionOrderFieldOccurredAt
+	CommitContributionOrderFieldCommitCount = githubv4.CommitContributionOrderFieldCommitCount
+)
+
+// CommitMessage is an alias of githubv4.CommitMessage.
+type CommitMessage = githubv4.CommitMessage
+
+// CommitMessagePatternParametersInput is an alias of githubv4.CommitMessagePatternParametersInput.
+type CommitMessagePatternParametersInput = githubv4.CommitMessagePatternParametersInput
+
+// CommittableBranch is an alias of githubv4.CommittableBranch.
+type CommittableBranch = githubv4.CommittableBranch
+
+// CommitterEmailPatternParametersInput is an alias of githubv4.CommitterEmailPatternParametersInput.
+type CommitterEmailPatternParametersInput = githubv4.CommitterEmailPatternParametersInput
+
+// ComparisonStatus is an alias of githubv4.ComparisonStatus.
+type ComparisonStatus = githubv4.ComparisonStatus
+
+const (
+	ComparisonStatusDiverged  = githubv4.ComparisonStatusDiverged
+	ComparisonStatusAhead     = githubv4.ComparisonStatusAhead
+	ComparisonStatusBehind    = githubv4.ComparisonStatusBehind
+	ComparisonStatusIdentical = githubv4.ComparisonStatusIdentical
+)
+
+// ContributionLevel is an alias of githubv4.ContributionLevel.
+type ContributionLevel = githubv4.ContributionLevel
+
+const (
+	ContributionLevelNone           = githubv4.ContributionLevelNone
+	ContributionLevelFirstQuartile  = githubv4.ContributionLevelFirstQuartile
+	ContributionLevelSecondQuartile = githubv4.ContributionLevelSecondQuartile
+	ContributionLevelThirdQuartile  = githubv4.ContributionLevelThirdQuartile
+	ContributionLevelFourthQuartile = githubv4.ContributionLevelFourthQuartile
+)
+
+// ContributionOrder is an alias of githubv4.ContributionOrder.
+type ContributionOrder = githubv4.ContributionOrder
+
+// ConvertProjectCardNoteToIssueInput is an alias of githubv4.ConvertProjectCardNoteToIssueInput.
+type ConvertProjectCardNoteToIssueInput = githubv4.ConvertProjectCardNoteToIssueInput
+
+// ConvertPullRequestToDraftInput is an alias of githubv4.ConvertPullRequestToDraftInput.
+type ConvertPullRequestToDraftInput = githubv4.ConvertPullRequestToDraftInput
+
+// CopyProjectV2Input is an alias of githubv4.CopyProjectV2Input.
+type CopyProjectV2Input = githubv4.CopyProjectV2Input
+
+// CreateAttributionInvitationInput is an alias of githubv4.CreateAttributionInvitationInput.
+type CreateAttributionInvitationInput = githubv4.CreateAttributionInvitationInput
+
+// CreateBranchProtectionRuleInput is an alias of githubv4.CreateBranchProtectionRuleInput.
+type CreateBranchProtectionRuleInput = githubv4.CreateBranchProtectionRuleInput
+
+// CreateCheckRunInput is an alias of githubv4.CreateCheckRunInput.
+type CreateCheckRunInput = githubv4.CreateCheckRunInput
+
+// CreateCheckSuiteInput is an alias of githubv4.CreateCheckSuiteInput.
+type CreateCheckSuiteInput = githubv4.CreateCheckSuiteInput
+
+// CreateCommitOnBranchInput is an alias of githubv4.CreateCommitOnBranchInput.
+type CreateCommitOnBranchInput = githubv4.CreateCommitOnBranchInput
+
+// CreateDiscussionInput is an alias of githubv4.CreateDiscussionInput.
+type CreateDiscussionInput = githubv4.CreateDiscussionInput
+
+// CreateEnterpriseOrganizationInput is an alias of githubv4.CreateEnterpriseOrganizationInput.
+type CreateEnterpriseOrganizationInput = githubv4.CreateEnterpriseOrganizationInput
+
+// CreateEnvironmentInput is an alias of githubv4.CreateEnvironmentInput.
+type CreateEnvironmentInput = githubv4.CreateEnvironmentInput
+
+// CreateIPAllowListEntryInput is an alias of githubv4.CreateIPAllowListEntryInput.
+type CreateIPAllowListEntryInput = githubv4.CreateIPAllowListEntryInput
+
+// CreateIssueInput is an alias of githubv4.CreateIssueInput.
+type CreateIssueInput = githubv4.CreateIssueInput
+
+// CreateLinkedBranchInput is an alias of githubv4.CreateLinkedBranchInput.
+type CreateLinkedBranchInput = githubv4.CreateLinkedBranchInput
+
+// CreateMigrationSourceInput is an alias of githubv4.CreateMigrationSourceInput.
+type CreateMigrationSourceInput = githubv4.CreateMigrationSourceInput
+
+// CreateProjectInput is an alias of githubv4.CreateProjectInput.
+type CreateProjectInput = githubv4.CreateProjectInput
+
+// CreateProjectV2FieldInput is an alias of githubv4.CreateProjectV2FieldInput.
+type CreateProjectV2FieldInput = githubv4.CreateProjectV2FieldInput
+
+// CreateProjectV2Input is an alias of githubv4.CreateProjectV2Input.
+type CreateProjectV2Input = githubv4.CreateProjectV2Input
+
+// CreatePullRequestInput is an alias of githubv4.CreatePullRequestInput.
+type CreatePullRequestInput = githubv4.CreatePullRequestInput
+
+// CreateRefInput is an alias of githubv4.CreateRefInput.
+type CreateRefInput = githubv4.CreateRefInput
+
+// CreateRepositoryInput is an alias of githubv4.CreateRepositoryInput.
+type CreateRepositoryInput = githubv4.CreateRepositoryInput
+
+// CreateRepositoryRulesetInput is an alias of githubv4.CreateRepositoryRulesetInput.
+type CreateRepositoryRulesetInput = githubv4.CreateRepositoryRulesetInput
+
+// CreateSponsorsListingInput is an alias of githubv4.CreateSponsorsListingInput.
+type CreateSponsorsListingInput = githubv4.CreateSponsorsListingInput
+
+// CreateSponsorsTierInput is an alias of githubv4.CreateSponsorsTierInput.
+type CreateSponsorsTierInput = githubv4.CreateSponsorsTierInput
+
+// CreateSponsorshipInput is an alias of githubv4.CreateSponsorshipInput.
+type CreateSponsorshipInput = githubv4.CreateSponsorshipInput
+
+// CreateSponsorshipsInput is an alias of githubv4.CreateSponsorshipsInput.
+type CreateSponsorshipsInput = githubv4.CreateSponsorshipsInput
+
+// DeclineTopicSuggestionInput is an alias of githubv4.DeclineTopicSuggestionInput.
+type DeclineTopicSuggestionInput = githubv4.DeclineTopicSuggestionInput
+
+// DefaultRepositoryPermissionField is an alias of githubv4.DefaultRepositoryPermissionField.
+type DefaultRepositoryPermissionField = githubv4.DefaultRepositoryPermissionField
+
+const (
+	DefaultRepositoryPermissionFieldNone  = githubv4.DefaultRepositoryPermissionFieldNone
+	DefaultRepositoryPermissionFieldRead  = githubv4.DefaultRepositoryPermissionFieldRead
+	DefaultRepositoryPermissionFieldWrite = githubv4.DefaultRepositoryPermissionFieldWrite
+	DefaultRepositoryPermissionFieldAdmin = githubv4.DefaultRepositoryPermissionFieldAdmin
+)
+
+// DeleteBranchProtectionRuleInput is an alias of githubv4.DeleteBranchProtectionRuleInput.
+type DeleteBranchProtectionRuleInput = githubv4.DeleteBranchProtectionRuleInput
+
+// DeleteDeploymentInput is an alias of githubv4.DeleteDeploymentInput.
+type DeleteDeploymentInput = githubv4.DeleteDeploymentInput
+
+// DeleteDiscussionCommentInput is an alias of githubv4.DeleteDiscussionCommentInput.
+type DeleteDiscussionCommentInput = githubv4.DeleteDiscussionCommentInput
+
+// DeleteDiscussionInput is an alias of githubv4.DeleteDiscussionInput.
+type DeleteDiscussionInput = githubv4.DeleteDiscussionInput
+
+// DeleteEnvironmentInput is an alias of githubv4.DeleteEnvironmentInput.
+type DeleteEnvironmentInput = githubv4.DeleteEnvironmentInput
+
+// DeleteIPAllowListEntryInput is an alias of githubv4.DeleteIPAllowListEntryInput.
+type DeleteIPAllowListEntryInput = githubv4.DeleteIPAllowListEntryInput
+
+// DeleteIssueCommentInput is an alias of githubv4.DeleteIssueCommentInput.
+type DeleteIssueCommentInput = githubv4.DeleteIssueCommentInput
+
+// DeleteIssueInput is an alias of githubv4.DeleteIssueInput.
+type DeleteIssueInput = githubv4.DeleteIssueInput
+
+// DeleteLinkedBranchInput is an alias of githubv4.DeleteLinkedBranchInput.
+type DeleteLinkedBranchInput = githubv4.DeleteLinkedBranchInput
+
+// DeleteProjectCardInput is an alias of githubv4.DeleteProjectCardInput.
+type DeleteProjectCardInput = githubv4.DeleteProjectCardInput
+
+// DeleteProjectColumnInput is an alias of githubv4.DeleteProjectColumnInput.
+type DeleteProjectColumnInput = githubv4.DeleteProjectColumnInput
+
+// DeleteProjectInput is an alias of githubv4.DeleteProjectInput.
+type DeleteProjectInput = githubv4.DeleteProjectInput
+
+// DeleteProjectV2FieldInput is an alias of githubv4.DeleteProjectV2FieldInput.
+type DeleteProjectV2FieldInput = githubv4.DeleteProjectV2FieldInput
+
+// DeleteProjectV2Input is an alias of githubv4.DeleteProjectV2Input.
+type DeleteProjectV2Input = githubv4.DeleteProjectV2Input
+
+// DeleteProjectV2ItemInput is an alias of githubv4.DeleteProjectV2ItemInput.
+type DeleteProjectV2ItemInput = githubv4.DeleteProjectV2ItemInput
+
+// DeleteProjectV2WorkflowInput is an alias of githubv4.DeleteProjectV2WorkflowInput.
+type DeleteProjectV2WorkflowInput = githubv4.DeleteProjectV2WorkflowInput
+
+// DeletePullRequestReviewCommentInput is an alias of githubv4.DeletePullRequestReviewCommentInput.
+type DeletePullRequestReviewCommentInput = githubv4.DeletePullRequestReviewCommentInput
+
+// DeletePullRequestReviewInput is an alias of githubv4.DeletePullRequestReviewInput.
+type DeletePullRequestReviewInput = githubv4.DeletePullRequestReviewInput
+
+// DeleteRefInput is an alias of githubv4.DeleteRefInput.
+type DeleteRefInput = githubv4.DeleteRefInput
+
+// DeleteRepositoryRulesetInput is an alias of githubv4.DeleteRepositoryRulesetInput.
+type DeleteRepositoryRulesetInput = githubv4.DeleteRepositoryRulesetInput
+
+// DeleteTeamDiscussionCommentInput is an alias of githubv4.DeleteTeamDiscussionCommentInput.
+type DeleteTeamDiscussionCommentInput = githubv4.DeleteTeamDiscussionCommentInput
+
+// DeleteTeamDiscussionInput is an alias of githubv4.DeleteTeamDiscussionInput.
+type DeleteTeamDiscussionInput = githubv4.DeleteTeamDiscussionInput
+
+// DeleteVerifiableDomainInput is an alias of githubv4.DeleteVerifiableDomainInput.
+type DeleteVerifiableDomainInput = githubv4.DeleteVerifiableDomainInput
+
+// DependencyGraphEcosystem is an alias of githubv4.DependencyGraphEcosystem.
+type DependencyGraphEcosystem = githubv4.DependencyGraphEcosystem
+
+const (
+	DependencyGraphEcosystemRubygems = githubv4.DependencyGraphEcosystemRubygems
+	DependencyGraphEcosystemNpm      = githubv4.DependencyGraphEcosystemNpm
+	DependencyGraphEcosystemPip      = githubv4.DependencyGraphEcosystemPip
+	DependencyGraphEcosystemMaven    = githubv4.DependencyGraphEcosystemMaven
+	DependencyGraphEcosystemNuget    = githubv4.DependencyGraphEcosystemNuget
+	DependencyGraphEcosystemComposer = githubv4.DependencyGraphEcosystemComposer
+	DependencyGraphEcosystemGo       = githubv4.DependencyGraphEcosystemGo
+	DependencyGraphEcosystemActions  = githubv4.DependencyGraphEcosystemActions
+	DependencyGraphEcosystemRust     = githubv4.DependencyGraphEcosystemRust
+	DependencyGraphEcosystemPub      = githubv4.DependencyGraphEcosystemPub
+	DependencyGraphEcosystemSwift    = githubv4.DependencyGraphEcosystemSwift
+)
+
+// DeploymentOrder is an alias of githubv4.DeploymentOrder.
+type DeploymentOrder = githubv4.DeploymentOrder
+
+// DeploymentOrderField is an alias of githubv4.DeploymentOrderField.
+type DeploymentOrderField = githubv4.DeploymentOrderField
+
+const (
+	DeploymentOrderFieldCreatedAt = githubv4.DeploymentOrderFieldCreatedAt
+)
+
+// DeploymentProtectionRuleType is an alias of githubv4.DeploymentProtectionRuleType.
+type DeploymentProtectionRuleType = githubv4.DeploymentProtectionRuleType
+
+const (
+	DeploymentProtectionRuleTypeRequiredReviewers = githubv4.DeploymentProtectionRuleTypeRequiredReviewers
+	DeploymentProtectionRuleTypeWaitTimer         = githubv4.DeploymentProtectionRuleTypeWaitTimer
+)
+
+// DeploymentReviewState is an alias of githubv4.DeploymentReviewState.
+type DeploymentReviewState = githubv4.DeploymentReviewState
+
+const (
+	DeploymentReviewStateApproved = githubv4.DeploymentReviewStateApproved
+	DeploymentReviewStateRejected = githubv4.DeploymentReviewStateRejected
+)
+
+// DeploymentState is an alias of githubv4.DeploymentState.
+type DeploymentState = githubv4.DeploymentState
+
+const (
+	DeploymentStateAbandoned  = githubv4.DeploymentStateAbandoned
+	DeploymentStateActive     = githubv4.DeploymentStateActive
+	DeploymentStateDestroyed  = githubv4.DeploymentStateDestroyed
+	DeploymentStateError      = githubv4.DeploymentStateError
+	DeploymentStateFailure    = githubv4.DeploymentStateFailure
+	DeploymentStateInactive   = githubv4.DeploymentStateInactive
+	DeploymentStatePending    = githubv4.DeploymentStatePending
+	DeploymentStateSuccess    = githubv4.DeploymentStateSuccess
+	DeploymentStateQueued     = githubv4.DeploymentStateQueued
+	DeploymentStateInProgress = githubv4.DeploymentStateInProgress
+	DeploymentStateWaiting    = githubv4.DeploymentStateWaiting
+)
+
+// DeploymentStatusState is an alias of githubv4.DeploymentStatusState.
+type DeploymentStatusState = githubv4.DeploymentStatusState
+
+const (
+	DeploymentStatusStatePending    = githubv4.DeploymentStatusStatePending
+	DeploymentStatusStateSuccess    = githubv4.DeploymentStatusStateSuccess
+	DeploymentStatusStateFailure    = githubv4.DeploymentStatusStateFailure
+	DeploymentStatusStateInactive   = githubv4.DeploymentStatusStateInactive
+	DeploymentStatusStateError      = githubv4.DeploymentStatusStateError
+	DeploymentStatusStateQueued     = githubv4.DeploymentStatusStateQueued
+	DeploymentStatusStateInProgress = githubv4.DeploymentStatusStateInProgress
+	DeploymentStatusStateWaiting    = githubv4.DeploymentStatusStateWaiting
+)
+
+// DequeuePullRequestInput is an alias of githubv4.DequeuePullRequestInput.
+type DequeuePullRequestInput = githubv4.DequeuePullRequestInput
+
+// DiffSide is an alias of githubv4.DiffSide.
+type DiffSide = githubv4.DiffSide
+
+const (
+	DiffSideLeft  = githubv4.DiffSideLeft
+	DiffSideRight = githubv4.DiffSideRight
+)
+
+// DisablePullRequestAutoMergeInput is an alias of githubv4.DisablePullRequestAutoMergeInput.
+type DisablePullRequestAutoMergeInput = githubv4.DisablePullRequestAutoMergeInput
+
+// DiscussionCloseReason is an alias of githubv4.DiscussionCloseReason.
+type DiscussionCloseReason = githubv4.DiscussionCloseReason
+
+const (
+	DiscussionCloseReasonResolved  = githubv4.DiscussionCloseReasonResolved
+	DiscussionCloseReasonOutdated  = githubv4.DiscussionCloseReasonOutdated
+	DiscussionCloseReasonDuplicate = githubv4.DiscussionCloseReasonDuplicate
+)
+
+// DiscussionOrder is an alias of githubv4.DiscussionOrder.
+type DiscussionOrder = githubv4.DiscussionOrder
+
+// DiscussionOrderField is an alias of githubv4.DiscussionOrderField.
+type DiscussionOrderField = githubv4.DiscussionOrderField
+
+const (
+	DiscussionOrderFieldCreatedAt = githubv4.DiscussionOrderFieldCreatedAt
+	DiscussionOrderFieldUpdatedAt = githubv4.DiscussionOrderFieldUpdatedAt
+)
+
+// DiscussionPollOptionOrder is an alias of githubv4.DiscussionPollOptionOrder.
+type DiscussionPollOptionOrder = githubv4.DiscussionPollOptionOrder
+
+// DiscussionPollOptionOrderField is an alias of githubv4.DiscussionPollOptionOrderField.
+type DiscussionPollOptionOrderField = githubv4.DiscussionPollOptionOrderField
+
+const (
+	DiscussionPollOptionOrderFieldAuthoredOrder = githubv4.DiscussionPollOptionOrderFieldAuthoredOrder
+	DiscussionPollOptionOrderFieldVoteCount     = githubv4.DiscussionPollOptionOrderFieldVoteCount
+)
+
+// DiscussionState is an alias of githubv4.DiscussionState.
+type DiscussionState = githubv4.DiscussionState
+
+const (
+	DiscussionStateOpen   = githubv4.DiscussionStateOpen
+	DiscussionStateClosed = githubv4.DiscussionStateClosed
+)
+
+// DiscussionStateReason is an alias of githubv4.DiscussionStateReason.
+type DiscussionStateReason = githubv4.DiscussionStateReason
+
+const (
+	DiscussionStateReasonResolved  = githubv4.DiscussionStateReasonResolved
+	DiscussionStateReasonOutdated  = githubv4.DiscussionStateReasonOutdated
+	DiscussionStateReasonDuplicate = githubv4.DiscussionStateReasonDuplicate
+	DiscussionStateReasonReopened  = githubv4.DiscussionStateReasonReopened
+)
+
+// DismissPullRequestReviewInput is an alias of githubv4.DismissPullRequestReviewInput.
+type DismissPullRequestReviewInput = githubv4.DismissPullRequestReviewInput
+
+// DismissReason is an alias of githubv4.DismissReason.
+type DismissReason = githubv4.DismissReason
+
+const (
+	DismissReasonFixStarted    = githubv4.DismissReasonFixStarted
+	DismissReasonNoBandwidth   = githubv4.DismissReasonNoBandwidth
+	DismissReasonTolerableRisk = githubv4.DismissReasonTolerableRisk
+	DismissReasonInaccurate    = githubv4.DismissReasonInaccurate
+	DismissReasonNotUsed       = githubv4.DismissReasonNotUsed
+)
+
+// DismissRepositoryVulnerabilityAlertInput is an alias of githubv4.DismissRepositoryVulnerabilityAlertInput.
+type DismissRepositoryVulnerabilityAlertInput = githubv4.DismissRepositoryVulnerabilityAlertInput
+
+// DraftPullRequestReviewComment is an alias of githubv4.DraftPullRequestReviewComment.
+type DraftPullRequestReviewComment = githubv4.DraftPullRequestReviewComment
+
+// DraftPullRequestReviewThread is an alias of githubv4.DraftPullRequestReviewThread.
+type DraftPullRequestReviewThread = githubv4.DraftPullRequestReviewThread
+
+// EnablePullRequestAutoMergeInput is an alias of githubv4.EnablePullRequestAutoMergeInput.
+type EnablePullRequestAutoMergeInput = githubv4.EnablePullRequestAutoMergeInput
+
+// EnqueuePullRequestInput is an alias of githubv4.EnqueuePullRequestInput.
+type EnqueuePullRequestInput = githubv4.EnqueuePullRequestInput
+
+// EnterpriseAdministratorInvitationOrder is an alias of githubv4.EnterpriseAdministratorInvitationOrder.
+type EnterpriseAdministratorInvitationOrder = githubv4.EnterpriseAdministratorInvitationOrder
+
+// EnterpriseAdministratorInvitationOrderField is an alias of githubv4.EnterpriseAdministratorInvitationOrderField.
+type EnterpriseAdministratorInvitationOrderField = githubv4.EnterpriseAdministratorInvitationOrderField
+
+const (
+	EnterpriseAdministratorInvitationOrderFieldCreatedAt = githubv4.EnterpriseAdministratorInvitationOrderFieldCreatedAt
+)
+
+// EnterpriseAdministratorRole is an alias of githubv4.EnterpriseAdministratorRole.
+type EnterpriseAdministratorRole = githubv4.EnterpriseAdministratorRole
+
+const (
+	EnterpriseAdministratorRoleOwner          = githubv4.EnterpriseAdministratorRoleOwner
+	EnterpriseAdministratorRoleBillingManager = githubv4.EnterpriseAdministratorRoleBillingManager
+)
+
+// EnterpriseAllowPrivateRepositoryForkingPolicyValue is an alias of githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValue.
+type EnterpriseAllowPrivateRepositoryForkingPolicyValue = githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValue
+
+const (
+	EnterpriseAllowPrivateRepositoryForkingPolicyValueEnterpriseOrganizations             = githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValueEnterpriseOrganizations
+	EnterpriseAllowPrivateRepositoryForkingPolicyValueSameOrganization                    = githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValueSameOrganization
+	EnterpriseAllowPrivateRepositoryForkingPolicyValueSameOrganizationUserAccounts        = githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValueSameOrganizationUserAccounts
+	EnterpriseAllowPrivateRepositoryForkingPolicyValueEnterpriseOrganizationsUserAccounts = githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValueEnterpriseOrganizationsUserAccounts
+	EnterpriseAllowPrivateRepositoryForkingPolicyValueUserAccounts                        = githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValueUserAccounts
+	EnterpriseAllowPrivateRepositoryForkingPolicyValueEverywhere                          = githubv4.EnterpriseAllowPrivateRepositoryForkingPolicyValueEverywhere
+)
+
+// EnterpriseDefaultRepositoryPermissionSettingValue is an alias of githubv4.EnterpriseDefaultRepositoryPermissionSettingValue.
+type EnterpriseDefaultRepositoryPermissionSettingValue = githubv4.EnterpriseDefaultRepositoryPermissionSettingValue
+
+const (
+	EnterpriseDefaultRepositoryPermissionSettingValueNoPolicy = githubv4.EnterpriseDefaultRepositoryPermissionSettingValueNoPolicy
+	EnterpriseDefaultRepositoryPermissionSettingValueAdmin    = githubv4.EnterpriseDefaultRepositoryPermissionSettingValueAdmin
+	EnterpriseDefaultRepositoryPermissionSettingValueWrite    = githubv4.EnterpriseDefaultRepositoryPermissionSettingValueWrite
+	EnterpriseDefaultRepositoryPermissionSettingValueRead     = githubv4.EnterpriseDefaultRepositoryPermissionSettingValueRead
+	EnterpriseDefaultRepositoryPermissionSettingValueNone     = githubv4.EnterpriseDefaultRepositoryPermissionSettingValueNone
+)
+
+// EnterpriseEnabledDisabledSettingValue is an alias of githubv4.EnterpriseEnabledDisabledSettingValue.
+type EnterpriseEnabledDisabledSettingValue = githubv4.EnterpriseEnabledDisabledSettingValue
+
+const (
+	EnterpriseEnabledDisabledSettingValueEnabled  = githubv4.EnterpriseEnabledDisabledSettingValueEnabled
+	EnterpriseEnabledDisabledSettingValueDisabled = githubv4.EnterpriseEnabledDisabledSettingValueDisabled
+	EnterpriseEnabledDisabledSettingValueNoPolicy = githubv4.EnterpriseEnabledDisabledSettingValueNoPolicy
+)
+
+// EnterpriseEnabledSettingValue is an alias of githubv4.EnterpriseEnabledSettingValue.
+type EnterpriseEnabledSettingValue = githubv4.EnterpriseEnabledSettingValue
+
+const (
+	EnterpriseEnabledSettingValueEnabled  = githubv4.EnterpriseEnabledSettingValueEnabled
+	EnterpriseEnabledSettingValueNoPolicy = githubv4.EnterpriseEnabledSettingValueNoPolicy
+)
+
+// EnterpriseMemberOrder is an alias of githubv4.EnterpriseMemberOrder.
+type EnterpriseMemberOrder = githubv4.EnterpriseMemberOrder
+
+// EnterpriseMemberOrderField is an alias of githubv4.EnterpriseMemberOrderField.
+type EnterpriseMemberOrderField = githubv4.EnterpriseMemberOrderField
+
+const (
+	EnterpriseMemberOrderFieldLogin     = githubv4.EnterpriseMemberOrderFieldLogin
+	EnterpriseMemberOrderFieldCreatedAt = githubv4.EnterpriseMemberOrderFieldCreatedAt
+)
+
+// EnterpriseMembersCanCreateRepositoriesSettingValue is an alias of githubv4.EnterpriseMembersCanCreateRepositoriesSettingValue.
+type EnterpriseMembersCanCreateRepositoriesSettingValue = githubv4.EnterpriseMembersCanCreateRepositoriesSettingValue
+
+const (
+	EnterpriseMembersCanCreateRepositoriesSettingValueNoPolicy = githubv4.EnterpriseMembersCanCreateRepositoriesSettingValueNoPolicy
+	EnterpriseMembersCanCreateRepositoriesSettingValueAll      = githubv4.EnterpriseMembersCanCreateRepositoriesSettingValueAll
+	EnterpriseMembersCanCreateRepositoriesSettingValuePublic   = githubv4.EnterpriseMembersCanCreateRepositoriesSettingValuePublic
+	EnterpriseMembersCanCreateRepositoriesSettingValuePrivate  = githubv4.EnterpriseMembersCanCreateRepositoriesSettingValuePrivate
+	EnterpriseMembersCanCreateRepositoriesSettingValueDisabled = githubv4.EnterpriseMembersCanCreateRepositoriesSettingValueDisabled
+)
+
+// EnterpriseMembersCanMakePurchasesSettingValue is an alias of githubv4.EnterpriseMembersCanMakePurchasesSettingValue.
+type EnterpriseMembersCanMakePurchasesSettingValue = githubv4.EnterpriseMembersCanMakePurchasesSettingValue
+
+const (
+	EnterpriseMembersCanMakePurchasesSettingValueEnabled  = githubv4.EnterpriseMembersCanMakePurchasesSettingValueEnabled
+	EnterpriseMembersCanMakePurchasesSettingValueDisabled = githubv4.EnterpriseMembersCanMakePurchasesSettingValueDisabled
+)
+
+// EnterpriseMembershipType is an alias of githubv4.EnterpriseMembershipType.
+type EnterpriseMembershipType = githubv4.EnterpriseMembershipType
+
+const (
+	EnterpriseMembershipTypeAll            = githubv4.EnterpriseMembershipTypeAll
+	EnterpriseMembershipTypeAdmin          = githubv4.EnterpriseMembershipTypeAdmin
+	EnterpriseMembershipTypeBillingManager = githubv4.EnterpriseMembershipTypeBillingManager
+	EnterpriseMembershipTypeOrgMembership  = githubv4.EnterpriseMembershipTypeOrgMembership
+)
+
+// EnterpriseOrder is an alias of githubv4.EnterpriseOrder.
+type EnterpriseOrder = githubv4.EnterpriseOrder
+
+// EnterpriseOrderField is an alias of githubv4.EnterpriseOrderField.
+type EnterpriseOrderField = githubv4.EnterpriseOrderField
+
+const (
+	EnterpriseOrderFieldName = githubv4.EnterpriseOrderFieldName
+)
+
+// EnterpriseServerInstallationOrder is an alias of githubv4.EnterpriseServerInstallationOrder.
+type EnterpriseServerInstallationOrder = githubv4.EnterpriseServerInstallationOrder
+
+// EnterpriseServerInstallationOrderField is an alias of githubv4.EnterpriseServerInstallationOrderField.
+type EnterpriseServerInstallationOrderField = githubv4.EnterpriseServerInstallationOrderField
+
+const (
+	EnterpriseServerInstallationOrderFieldHostName     = githubv4.EnterpriseServerInstallationOrderFieldHostName
+	EnterpriseServerInstallationOrderFieldCustomerName = githubv4.EnterpriseServerInstallationOrderFieldCustomerName
+	EnterpriseServerInstallationOrderFieldCreatedAt    = githubv4.EnterpriseServerInstallationOrderFieldCreatedAt
+)
+
+// EnterpriseServerUserAccountEmailOrder is an alias of githubv4.EnterpriseServerUserAccountEmailOrder.
+type EnterpriseServerUserAccountEmailOrder = githubv4.EnterpriseServerUserAccountEmailOrder
+
+// EnterpriseServerUserAccountEmailOrderField is an alias of githubv4.EnterpriseServerUserAccountEmailOrderField.
+type EnterpriseServerUserAccountEmailOrderField = githubv4.EnterpriseServerUserAccountEmailOrderField
+
+const (
+	EnterpriseServerUserAccountEmailOrderFieldEmail = githubv4.EnterpriseServerUserAccountEmailOrderFieldEmail
+)
+
+// EnterpriseServerUserAccountOrder is an alias of githubv4.EnterpriseServerUserAccountOrder.
+type EnterpriseServerUserAccountOrder = githubv4.EnterpriseServerUserAccountOrder
+
+// EnterpriseServerUserAccountOrderField is an alias of githubv4.EnterpriseServerUserAccountOrderField.
+type EnterpriseServerUserAccountOrderField = githubv4.EnterpriseServerUserAccountOrderField
+
+const (
+	EnterpriseServerUserAccountOrderFieldLogin           = githubv4.EnterpriseServerUserAccountOrderFieldLogin
+	EnterpriseServerUserAccountOrderFieldRemoteCreatedAt = githubv4.EnterpriseServerUserAccountOrderFieldRemoteCreatedAt
+)
+
+// EnterpriseServerUserAccountsUploadOrder is an alias of githubv4.EnterpriseServerUserAccountsUploadOrder.
+type EnterpriseServerUserAccountsUploadOrder = githubv4.EnterpriseServerUserAccountsUploadOrder
+
+// EnterpriseServerUserAccountsUploadOrderField is an alias of githubv4.EnterpriseServerUserAccountsUploadOrderField.
+type EnterpriseServerUserAccountsUploadOrderField = githubv4.EnterpriseServerUserAccountsUploadOrderField
+
+const (
+	EnterpriseServerUserAccountsUploadOrderFieldCreatedAt = githubv4.EnterpriseServerUserAccountsUploadOrderFieldCreatedAt
+)
+
+// EnterpriseServerUserAccountsUploadSyncState is an alias of githubv4.EnterpriseServerUserAccountsUploadSyncState.
+type EnterpriseServerUserAccountsUploadSyncState = githubv4.EnterpriseServerUserAccountsUploadSyncState
+
+const (
+	EnterpriseServerUserAccountsUploadSyncStatePending = githubv4.EnterpriseServerUserAccountsUploadSyncStatePending
+	EnterpriseServerUserAccountsUploadSyncStateSuccess = githubv4.EnterpriseServerUserAccountsUploadSyncStateSuccess
+	EnterpriseServerUserAccountsUploadSyncStateFailure = githubv4.EnterpriseServerUserAccountsUploadSyncStateFailure
+)
+
+// EnterpriseUserAccountMembershipRole is an alias of githubv4.EnterpriseUserAccountMembershipRole.
+type EnterpriseUserAccountMembershipRole = githubv4.EnterpriseUserAccountMembershipRole
+
+const (
+	EnterpriseUserAccountMembershipRoleMember       = githubv4.EnterpriseUserAccountMembershipRoleMember
+	EnterpriseUserAccountMembershipRoleOwner        = githubv4.EnterpriseUserAccountMembershipRoleOwner
+	EnterpriseUserAccountMembershipRoleUnaffiliated = githubv4.EnterpriseUserAccountMembershipRoleUnaffiliated
+)
+
+// EnterpriseUserDeployment is an alias of githubv4.EnterpriseUserDeployment.
+type EnterpriseUserDeployment = githubv4.EnterpriseUserDeployment
+
+const (
+	EnterpriseUserDeploymentCloud  = githubv4.EnterpriseUserDeploymentCloud
+	EnterpriseUserDeploymentServer = githubv4.EnterpriseUserDeploymentServer
+)
+
+// EnvironmentOrderField is an alias of githubv4.EnvironmentOrderField.
+type EnvironmentOrderField = githubv4.EnvironmentOrderField
+
+const (
+	EnvironmentOrderFieldName = githubv4.EnvironmentOrderFieldName
+)
+
+// Environments is an alias of githubv4.Environments.
+type Environments = githubv4.Environments
+
+// FileAddition is an alias of githubv4.FileAddition.
+type FileAddition = githubv4.FileAddition
+
+// FileChanges is an alias of githubv4.FileChanges.
+type FileChanges = githubv4.FileChanges
+
+// FileDeletion is an alias of githubv4.FileDeletion.
+type FileDeletion = githubv4.FileDeletion
+
+// FileViewedState is an alias of githubv4.FileViewedState.
+type FileViewedState = githubv4.FileViewedState
+
+const (
+	FileViewedStateDismissed = githubv4.FileViewedStateDismissed
+	FileViewedStateViewed    = githubv4.FileViewedStateViewed
+	FileViewedStateUnviewed  = githubv4.FileViewedStateUnviewed
+)
+
+// FollowOrganizationInput is an alias of githubv4.FollowOrganizationInput.
+type FollowOrganizationInput = githubv4.FollowOrganizationInput
+
+// FollowUserInput is an alias of githubv4.FollowUserInput.
+type FollowUserInput = githubv4.FollowUserInput
+
+// FundingPlatform is an alias of githubv4.FundingPlatform.
+type FundingPlatform = githubv4.FundingPlatform
+
+const (
+	FundingPlatformGitHub          = githubv4.FundingPlatformGitHub
+	FundingPlatformPatreon         = githubv4.FundingPlatformPatreon
+	FundingPlatformOpenCollective  = githubv4.FundingPlatformOpenCollective
+	FundingPlatformKoFi            = githubv4.FundingPlatformKoFi
+	FundingPlatformTidelift        = githubv4.FundingPlatformTidelift
+	FundingPlatformCommunityBridge = githubv4.FundingPlatformCommunityBridge
+	FundingPlatformLiberapay       = githubv4.FundingPlatformLiberapay
+	FundingPlatformIssueHunt       = githubv4.FundingPlatformIssueHunt
+	FundingPlatformOtechie         = githubv4.FundingPlatformOtechie
+	FundingPlatformLFXCrowdfunding = githubv4.FundingPlatformLFXCrowdfunding
+	FundingPlatformCustom          = githubv4.FundingPlatformCustom
+)
+
+// GistOrder is an alias of githubv4.GistOrder.
+type GistOrder = githubv4.GistOrder
+
+// GistOrderField is an alias of githubv4.GistOrderField.
+type GistOrderField = githubv4.GistOrderField
+
+const (
+	GistOrderFieldCreatedAt = githubv4.GistOrderFieldCreatedAt
+	GistOrderFieldUpdatedAt = githubv4.GistOrderFieldUpdatedAt
+	GistOrderFieldPushedAt  = githubv4.GistOrderFieldPushedAt
+)
+
+// GistPrivacy is an alias of githubv4.GistPrivacy.
+type GistPrivacy = githubv4.GistPrivacy
+
+const (
+	GistPrivacyPublic = githubv4.GistPrivacyPublic
+	GistPrivacySecret = githubv4.GistPrivacySecret
+	GistPrivacyAll    = githubv4.GistPrivacyAll
+)
+
+// GitSignatureState is an alias of githubv4.GitSignatureState.
+type GitSignatureState = githubv4.GitSignatureState
+
+const (
+	GitSignatureStateValid                = githubv4.GitSignatureStateValid
+	GitSignatureStateInvalid              = githubv4.GitSignatureStateInvalid
+	GitSignatureStateMalformedSig         = githubv4.GitSignatureStateMalformedSig
+	GitSignatureStateUnknownKey           = githubv4.GitSignatureStateUnknownKey
+	GitSignatureStateBadEmail             = githubv4.GitSignatureStateBadEmail
+	GitSignatureStateUnverifiedEmail      = githubv4.GitSignatureStateUnverifiedEmail
+	GitSignatureStateNoUser               = githubv4.GitSignatureStateNoUser
+	GitSignatureStateUnknownSigType       = githubv4.GitSignatureStateUnknownSigType
+	GitSignatureStateUnsigned             = githubv4.GitSignatureStateUnsigned
+	GitSignatureStateGpgverifyUnavailable = githubv4.GitSignatureStateGpgverifyUnavailable
+	GitSignatureStateGpgverifyError       = githubv4.GitSignatureStateGpgverifyError
+	GitSignatureStateNotSigningKey        = githubv4.GitSignatureStateNotSigningKey
+	GitSignatureStateExpiredKey           = githubv4.GitSignatureStateExpiredKey
+	GitSignatureStateOcspPending          = githubv4.GitSignatureStateOcspPending
+	GitSignatureStateOcspError            = githubv4.GitSignatureStateOcspError
+	GitSignatureStateBadCert              = githubv4.GitSignatureStateBadCert
+	GitSignatureStateOcspRevoked          = githubv4.GitSignatureStateOcspRevoked
+)
+
+// GrantEnterpriseOrganizationsMigratorRoleInput is an alias of githubv4.GrantEnterpriseOrganizationsMigratorRoleInput.
+type GrantEnterpriseOrganizationsMigratorRoleInput = githubv4.GrantEnterpriseOrganizationsMigratorRoleInput
+
+// GrantMigratorRoleInput is an alias of githubv4.GrantMigratorRoleInput.
+type GrantMigratorRoleInput = githubv4.GrantMigratorRoleInput
+
+// IdentityProviderConfigurationState is an alias of githubv4.IdentityProviderConfigurationState.
+type IdentityProviderConfigurationState = githubv4.IdentityProviderConfigurationState
+
+const (
+	IdentityProviderConfigurationStateEnforced     = githubv4.IdentityProviderConfigurationStateEnforced
+	IdentityProviderConfigurationStateConfigured   = githubv4.IdentityProviderConfigurationStateConfigured
+	IdentityProviderConfigurationStateUnconfigured = githubv4.IdentityProviderConfigurationStateUnconfigured
+)
+
+// InviteEnterpriseAdminInput is an alias of githubv4.InviteEnterpriseAdminInput.
+type InviteEnterpriseAdminInput = githubv4.InviteEnterpriseAdminInput
+
+// IPAllowListEnabledSettingValue is an alias of githubv4.IPAllowListEnabledSettingValue.
+type IPAllowListEnabledSettingValue = githubv4.IPAllowListEnabledSettingValue
+
+const (
+	IPAllowListEnabledSettingValueEnabled  = githubv4.IPAllowListEnabledSettingValueEnabled
+	IPAllowListEnabledSettingValueDisabled = githubv4.IPAllowListEnabledSettingValueDisabled
+)
+
+// IPAllowListEntryOrder is an alias of githubv4.IPAllowListEntryOrder.
+type IPAllowListEntryOrder = githubv4.IPAllowListEntryOrder
+
+// IPAllowListEntryOrderField is an alias of githubv4.IPAllowListEntryOrderField.
+type IPAllowListEntryOrderField = githubv4.IPAllowListEntryOrderField
+
+const (
+	IPAllowListEntryOrderFieldCreatedAt      = githubv4.IPAllowListEntryOrderFieldCreatedAt
+	IPAllowListEntryOrderFieldAllowListValue = githubv4.IPAllowListEntryOrderFieldAllowListValue
+)
+
+// IPAllowListForInstalledAppsEnabledSettingValue is an alias of githubv4.IPAllowListForInstalledAppsEnabledSettingValue.
+type IPAllowListForInstalledAppsEnabledSettingValue = githubv4.IPAllowListForInstalledAppsEnabledSettingValue
+
+const (
+	IPAllowListForInstalledAppsEnabledSettingValueEnabled  = githubv4.IPAllowListForInstalledAppsEnabledSettingValueEnabled
+	IPAllowListForInstalledAppsEnabledSettingValueDisabled = githubv4.IPAllowListForInstalledAppsEnabledSettingValueDisabled
+)
+
+// IssueClosedStateReason is an alias of githubv4.IssueClosedStateReason.
+type IssueClosedStateReason = githubv4.IssueClosedStateReason
+
+const (
+	IssueClosedStateReasonCompleted  = githubv4.IssueClosedStateReasonCompleted
+	IssueClosedStateReasonNotPlanned = githubv4.IssueClosedStateReasonNotPlanned
+)
+
+// IssueCommentOrder is an alias of githubv4.IssueCommentOrder.
+type IssueCommentOrder = githubv4.IssueCommentOrder
+
+// IssueCommentOrderField is an alias of githubv4.IssueCommentOrderField.
+type IssueCommentOrderField = githubv4.IssueCommentOrderField
+
+const (
+	IssueCommentOrderFieldUpdatedAt = githubv4.IssueCommentOrderFieldUpdatedAt
+)
+
+// IssueFilters is an alias of githubv4.IssueFilters.
+type IssueFilters = githubv4.IssueFilters
+
+// IssueOrder is an alias of githubv4.IssueOrder.
+type IssueOrder = githubv4.IssueOrder
+
+// IssueOrderField is an alias of githubv4.IssueOrderField.
+type IssueOrderField = githubv4.IssueOrderField
+
+const (
+	IssueOrderFieldCreatedAt = githubv4.IssueOrderFieldCreatedAt
+	IssueOrderFieldUpdatedAt = githubv4.IssueOrderFieldUpdatedAt
+	IssueOrderFieldComments  = githubv4.IssueOrderFieldComments
+)
+
+// IssueState is an alias of githubv4.IssueState.
+type IssueState = githubv4.IssueState
+
+const (
+	IssueStateOpen   = githubv4.IssueStateOpen
+	IssueStateClosed = githubv4.IssueStateClosed
+)
+
+// IssueStateReason is an alias of githubv4.IssueStateReason.
+type IssueStateReason = githubv4.IssueStateReason
+
+const (
+	IssueStateReasonReopened   = githubv4.IssueStateReasonReopened
+	IssueStateReasonNotPlanned = githubv4.IssueStateReasonNotPlanned
+	IssueStateReasonCompleted  = githubv4.IssueStateReasonCompleted
+)
+
+// IssueTimelineItemsItemType is an alias of githubv4.IssueTimelineItemsItemType.
+type IssueTimelineItemsItemType = githubv4.IssueTimelineItemsItemType
+
+const (
+	IssueTimelineItemsItemTypeIssueComment               = githubv4.IssueTimelineItemsItemTypeIssueComment
+	IssueTimelineItemsItemTypeCrossReferencedEvent       = githubv4.IssueTimelineItemsItemTypeCrossReferencedEvent
+	IssueTimelineItemsItemTypeAddedToProjectEvent        = githubv4.IssueTimelineItemsItemTypeAddedToProjectEvent
+	IssueTimelineItemsItemTypeAssignedEvent              = githubv4.IssueTimelineItemsItemTypeAssignedEvent
+	IssueTimelineItemsItemTypeClosedEvent                = githubv4.IssueTimelineItemsItemTypeClosedEvent
+	IssueTimelineItemsItemTypeCommentDeletedEvent        = githubv4.IssueTimelineItemsItemTypeCommentDeletedEvent
+	IssueTimelineItemsItemTypeConnectedEvent             = githubv4.IssueTimelineItemsItemTypeConnectedEvent
+	IssueTimelineItemsItemTypeConvertedNoteToIssueEvent  = githubv4.IssueTimelineItemsItemTypeConvertedNoteToIssueEvent
+	IssueTimelineItemsItemTypeConvertedToDiscussionEvent = githubv4.IssueTimelineItemsItemTypeConvertedToDiscussionEvent
+	IssueTimelineItemsItemTypeDemilestonedEvent          = githubv4.IssueTimelineItemsItemTypeDemilestonedEvent
+	IssueTimelineItemsItemTypeDisconnectedEvent          = githubv4.IssueTimelineItemsItemTypeDisconnectedEvent
+	IssueTimelineItemsItemTypeLabeledEvent               = githubv4.IssueTimelineItemsItemTypeLabeledEvent
+	IssueTimelineItemsItemTypeLockedEvent                = githubv4.IssueTimelineItemsItemTypeLockedEvent
+	IssueTimelineItemsItemTypeMarkedAsDuplicateEvent     = githubv4.IssueTimelineItemsItemTypeMarkedAsDuplicateEvent
+	IssueTimelineItemsItemTypeMentionedEvent             = githubv4.IssueTimelineItemsItemTypeMentionedEvent
+	IssueTimelineItemsItemTypeMilestonedEvent            = githubv4.IssueTimelineItemsItemTypeMilestonedEvent
+	IssueTimelineItemsItemTypeMovedColumnsInProjectEvent = githubv4.IssueTimelineItemsItemTypeMovedColumnsInProjectEvent
+	IssueTimelineItemsItemTypePinnedEvent                = githubv4.IssueTimelineItemsItemTypePinnedEvent
+	IssueTimelineItemsItemTypeReferencedEvent            = githubv4.IssueTimelineItemsItemTypeReferencedEvent
+	IssueTimelineItemsItemTypeRemovedFromProjectEvent    = githubv4.IssueTimelineItemsItemTypeRemovedFromProjectEvent
+	IssueTimelineItemsItemTypeRenamedTitleEvent          = githubv4.IssueTimelineItemsItemTypeRenamedTitleEvent
+	IssueTimelineItemsItemTypeReopenedEvent              = githubv4.IssueTimelineItemsItemTypeReopenedEvent
+	IssueTimelineItemsItemTypeSubscribedEvent            = githubv4.IssueTimelineItemsItemTypeSubscribedEvent
+	IssueTimelineItemsItemTypeTransferredEvent           = githubv4.IssueTimelineItemsItemTypeTransferredEvent
+	IssueTimelineItemsItemTypeUnassignedEvent            = githubv4.IssueTimelineItemsItemTypeUnassignedEvent
+	IssueTimelineItemsItemTypeUnlabeledEvent             = githubv4.IssueTimelineItemsItemTypeUnlabeledEvent
+	IssueTimelineItemsItemTypeUnlockedEvent              = githubv4.IssueTimelineItemsItemTypeUnlockedEvent
+	IssueTimelineItemsItemTypeUserBlockedEvent           = githubv4.IssueTimelineItemsItemTypeUserBlockedEvent
+	IssueTimelineItemsItemTypeUnmarkedAsDuplicateEvent   = githubv4.IssueTimelineItemsItemTypeUnmarkedAsDuplicateEvent
+	IssueTimelineItemsItemTypeUnpinnedEvent              = githubv4.IssueTimelineItemsItemTypeUnpinnedEvent
+	IssueTimelineItemsItemTypeUnsubscribedEvent          = githubv4.IssueTimelineItemsItemTypeUnsubscribedEvent
+)
+
+// LabelOrder is an alias of githubv4.LabelOrder.
+type LabelOrder = githubv4.LabelOrder
+
+// LabelOrderField is an alias of githubv4.LabelOrderField.
+type LabelOrderField = githubv4.LabelOrderField
+
+const (
+	LabelOrderFieldName      = githubv4.LabelOrderFieldName
+	LabelOrderFieldCreatedAt = githubv4.LabelOrderFieldCreatedAt
+)
+
+// LanguageOrder is an alias of githubv4.LanguageOrder.
+type LanguageOrder = githubv4.LanguageOrder
+
+// LanguageOrderField is an alias of githubv4.LanguageOrderField.
+type LanguageOrderField = githubv4.LanguageOrderField
+
+const (
+	LanguageOrderFieldSize = githubv4.LanguageOrderFieldSize
+)
+
+// LinkProjectV2ToRepositoryInput is an alias of githubv4.LinkProjectV2ToRepositoryInput.
+type LinkProjectV2ToRepositoryInput = githubv4.LinkProjectV2ToRepositoryInput
+
+// LinkProjectV2ToTeamInput is an alias of githubv4.LinkProjectV2ToTeamInput.
+type LinkProjectV2ToTeamInput = githubv4.LinkProjectV2ToTeamInput
+
+// LinkRepositoryToProjectInput is an alias of githubv4.LinkRepositoryToProjectInput.
+type LinkRepositoryToProjectInput = githubv4.LinkRepositoryToProjectInput
+
+// LockLockableInput is an alias of githubv4.LockLockableInput.
+type LockLockableInput = githubv4.LockLockableInput
+
+// LockReason is an alias of githubv4.LockReason.
+type LockReason = githubv4.LockReason
+
+const (
+	LockReasonOffTopic  = githubv4.LockReasonOffTopic
+	LockReasonTooHeated = githubv4.LockReasonTooHeated
+	LockReasonResolved  = githubv4.LockReasonResolved
+	LockReasonSpam      = githubv4.LockReasonSpam
+)
+
+// MannequinOrder is an alias of githubv4.MannequinOrder.
+type MannequinOrder = githubv4.MannequinOrder
+
+// MannequinOrderField is an alias of githubv4.MannequinOrderField.
+type MannequinOrderField = githubv4.MannequinOrderField
+
+const (
+	MannequinOrderFieldLogin     = githubv4.MannequinOrderFieldLogin
+	MannequinOrderFieldCreatedAt = githubv4.MannequinOrderFieldCreatedAt
+)
+
+// MarkDiscussionCommentAsAnswerInput is an alias of githubv4.MarkDiscussionCommentAsAnswerInput.
+type MarkDiscussionCommentAsAnswerInput = githubv4.MarkDiscussionCommentAsAnswerInput
+
+// MarkFileAsViewedInput is an alias of githubv4.MarkFileAsViewedInput.
+type MarkFileAsViewedInput = githubv4.MarkFileAsViewedInput
+
+// MarkProjectV2AsTemplateInput is an alias of githubv4.MarkProjectV2AsTemplateInput.
+type MarkProjectV2AsTemplateInput = githubv4.MarkProjectV2AsTemplateInput
+
+// MarkPullRequestReadyForReviewInput is an alias of githubv4.MarkPullRequestReadyForReviewInput.
+type MarkPullRequestReadyForReviewInput = githubv4.MarkPullRequestReadyForReviewInput
+
+// MergeBranchInput is an alias of githubv4.MergeBranchInput.
+type MergeBranchInput = githubv4.MergeBranchInput
+
+// MergeCommitMessage is an alias of githubv4.MergeCommitMessage.
+type MergeCommitMessage = githubv4.MergeCommitMessage
+
+const (
+	MergeCommitMessagePrTitle = githubv4.MergeCommitMessagePrTitle
+	MergeCommitMessagePrBody  = githubv4.MergeCommitMessagePrBody
+	MergeCommitMessageBlank   = githubv4.MergeCommitMessageBlank
+)
+
+// MergeCommitTitle is an alias of githubv4.MergeCommitTitle.
+type MergeCommitTitle = githubv4.MergeCommitTitle
+
+const (
+	MergeCommitTitlePrTitle      = githubv4.MergeCommitTitlePrTitle
+	MergeCommitTitleMergeMessage = githubv4.MergeCommitTitleMergeMessage
+)
+
+// MergePullRequestInput is an alias of githubv4.MergePullRequestInput.
+type MergePullRequestInput = githubv4.MergePullRequestInput
+
+// MergeQueueEntryState is an alias of githubv4.MergeQueueEntryState.
+type MergeQueueEntryState = githubv4.MergeQueueEntryState
+
+const (
+	MergeQueueEntryStateQueued         = githubv4.MergeQueueEntryStateQueued
+	MergeQueueEntryStateAwaitingChecks = githubv4.MergeQueueEntryStateAwaitingChecks
+	MergeQueueEntryStateMergeable      = githubv4.MergeQueueEntryStateMergeable
+	MergeQueueEntryStateUnmergeable    = githubv4.MergeQueueEntryStateUnmergeable
+	MergeQueueEntryStateLocked         = githubv4.MergeQueueEntryStateLocked
+)
+
+// MergeQueueMergingStrategy is an alias of githubv4.MergeQueueMergingStrategy.
+type MergeQueueMergingStrategy = githubv4.MergeQueueMergingStrategy
+
+const (
+	MergeQueueMergingStrategyAllgreen  = githubv4.MergeQueueMergingStrategyAllgreen
+	MergeQueueMergingStrategyHeadgreen = githubv4.MergeQueueMergingStrategyHeadgreen
+)
+
+// MergeableState is an alias of githubv4.MergeableState.
+type MergeableState = githubv4.MergeableState
+
+const (
+	MergeableStateMergeable   = githubv4.MergeableStateMergeable
+	MergeableStateConflicting = githubv4.MergeableStateConflicting
+	MergeableStateUnknown     = githubv4.MergeableStateUnknown
+)
+
+// MigrationSourceType is an alias of githubv4.MigrationSourceType.
+type MigrationSourceType = githubv4.MigrationSourceType
+
+const (
+	MigrationSourceTypeAzureDevOps     = githubv4.MigrationSourceTypeAzureDevOps
+	MigrationSourceTypeBitbucketServer = githubv4.MigrationSourceTypeBitbucketServer
+	MigrationSourceTypeGitHubArchive   = githubv4.MigrationSourceTypeGitHubArchive
+)
+
+// MigrationState is an alias of githubv4.MigrationState.
+type MigrationState = githubv4.MigrationState
+
+const (
+	MigrationStateNotStarted        = githubv4.MigrationStateNotStarted
+	MigrationStateQueued            = githubv4.MigrationStateQueued
+	MigrationStateInProgress        = githubv4.MigrationStateInProgress
+	MigrationStateSucceeded         = githubv4.MigrationStateSucceeded
+	MigrationStateFailed            = githubv4.MigrationStateFailed
+	MigrationStatePendingValidation = githubv4.MigrationStatePendingValidation
+	MigrationStateFailedValidation  = githubv4.MigrationStateFailedValidation
+)
+
+// MilestoneOrder is an alias of githubv4.MilestoneOrder.
+type MilestoneOrder = githubv4.MilestoneOrder
+
+// MilestoneOrderField is an alias of githubv4.MilestoneOrderField.
+type MilestoneOrderField = githubv4.MilestoneOrderField
+
+const (
+	MilestoneOrderFieldDueDate   = githubv4.MilestoneOrderFieldDueDate
+	MilestoneOrderFieldCreatedAt = githubv4.MilestoneOrderFieldCreatedAt
+	MilestoneOrderFieldUpdatedAt = githubv4.MilestoneOrderFieldUpdatedAt
+	MilestoneOrderFieldNumber    = githubv4.MilestoneOrderFieldNumber
+)
+
+// MilestoneState is an alias of githubv4.MilestoneState.
+type MilestoneState = githubv4.MilestoneState
+
+const (
+	MilestoneStateOpen   = githubv4.MilestoneStateOpen
+	MilestoneStateClosed = githubv4.MilestoneStateClosed
+)
+
+// MinimizeCommentInput is an alias of githubv4.MinimizeCommentInput.
+type MinimizeCommentInput = githubv4.MinimizeCommentInput
+
+// MoveProjectCardInput is an alias of githubv4.MoveProjectCardInput.
+type MoveProjectCardInput = githubv4.MoveProjectCardInput
+
+// MoveProjectColumnInput is an alias of githubv4.MoveProjectColumnInput.
+type MoveProjectColumnInput = githubv4.MoveProjectColumnInput
+
+// NotificationRestrictionSettingValue is an alias of githubv4.NotificationRestrictionSettingValue.
+type NotificationRestrictionSettingValue = githubv4.NotificationRestrictionSettingValue
+
+const (
+	NotificationRestrictionSettingValueEnabled  = githubv4.NotificationRestrictionSettingValueEnabled
+	NotificationRestrictionSettingValueDisabled = githubv4.NotificationRestrictionSettingValueDisabled
+)
+
+// OIDCProviderType is an alias of githubv4.OIDCProviderType.
+type OIDCProviderType = githubv4.OIDCProviderType
+
+const (
+	OIDCProviderTypeAad = githubv4.OIDCProviderTypeAad
+)
+
+// OauthApplicationCreateAuditEntryState is an alias of githubv4.OauthApplicationCreateAuditEntryState.
+type OauthApplicationCreateAuditEntryState = githubv4.OauthApplicationCreateAuditEntryState
+
+const (
+	OauthApplicationCreateAuditEntryStateActive          = githubv4.OauthApplicationCreateAuditEntryStateActive
+	OauthApplicationCreateAuditEntryStateSuspended       = githubv4.OauthApplicationCreateAuditEntryStateSuspended
+	OauthApplicationCreateAuditEntryStatePendingDeletion = githubv4.OauthApplicationCreateAuditEntryStatePendingDeletion
+)
+
+// OperationType is an alias of githubv4.OperationType.
+type OperationType = githubv4.OperationType
+
+const (
+	OperationTypeAccess         = githubv4.OperationTypeAccess
+	OperationTypeAuthentication = githubv4.OperationTypeAuthentication
+	OperationTypeCreate         = githubv4.OperationTypeCreate
+	OperationTypeModify         = githubv4.OperationTypeModify
+	OperationTypeRemove         = githubv4.OperationTypeRemove
+	OperationTypeRestore        = githubv4.OperationTypeRestore
+	OperationTypeTransfer       = githubv4.OperationTypeTransfer
+)
+
+// OrderDirection is an alias of githubv4.OrderDirection.
+type OrderDirection = githubv4.OrderDirection
+
+const (
+	OrderDirectionAsc  = githubv4.OrderDirectionAsc
+	OrderDirectionDesc = githubv4.OrderDirectionDesc
+)
+
+// OrgAddMemberAuditEntryPermission is an alias of githubv4.OrgAddMemberAuditEntryPermission.
+type OrgAddMemberAuditEntryPermission = githubv4.OrgAddMemberAuditEntryPermission
+
+const (
+	OrgAddMemberAuditEntryPermissionRead  = githubv4.OrgAddMemberAuditEntryPermissionRead
+	OrgAddMemberAuditEntryPermissionAdmin = githubv4.OrgAddMemberAuditEntryPermissionAdmin
+)
+
+// OrgCreateAuditEntryBillingPlan is an alias of githubv4.OrgCreateAuditEntryBillingPlan.
+type OrgCreateAuditEntryBillingPlan = githubv4.OrgCreateAuditEntryBillingPlan
+
+const (
+	OrgCreateAuditEntryBillingPlanFree          = githubv4.OrgCreateAuditEntryBillingPlanFree
+	OrgCreateAuditEntryBillingPlanBusiness      = githubv4.OrgCreateAuditEntryBillingPlanBusiness
+	OrgCreateAuditEntryBillingPlanBusinessPlus  = githubv4.OrgCreateAuditEntryBillingPlanBusinessPlus
+	OrgCreateAuditEntryBillingPlanUnlimited     = githubv4.OrgCreateAuditEntryBillingPlanUnlimited
+	OrgCreateAuditEntryBillingPlanTieredPerSeat = githubv4.OrgCreateAuditEntryBillingPlanTieredPerSeat
+)
+
+// OrgEnterpriseOwnerOrder is an alias of githubv4.OrgEnterpriseOwnerOrder.
+type OrgEnterpriseOwnerOrder = githubv4.OrgEnterpriseOwnerOrder
+
+// OrgEnterpriseOwnerOrderField is an alias of githubv4.OrgEnterpriseOwnerOrderField.
+type OrgEnterpriseOwnerOrderField = githubv4.OrgEnterpriseOwnerOrderField
+
+const (
+	OrgEnterpriseOwnerOrderFieldLogin = githubv4.OrgEnterpriseOwnerOrderFieldLogin
+)
+
+// OrgRemoveBillingManagerAuditEntryReason is an alias of githubv4.OrgRemoveBillingManagerAuditEntryReason.
+type OrgRemoveBillingManagerAuditEntryReason = githubv4.OrgRemoveBillingManagerAuditEntryReason
+
+const (
+	OrgRemoveBillingManagerAuditEntryReasonTwoFactorRequirementNonCompliance          = githubv4.OrgRemoveBillingManagerAuditEntryReasonTwoFactorRequirementNonCompliance
+	OrgRemoveBillingManagerAuditEntryReasonSamlExternalIdentityMissing                = githubv4.OrgRemoveBillingManagerAuditEntryReasonSamlExternalIdentityMissing
+	OrgRemoveBillingManagerAuditEntryReasonSamlSsoEnforcementRequiresExternalIdentity = githubv4.OrgRemoveBillingManagerAuditEntryReasonSamlSsoEnforcementRequiresExternalIdentity
+)
+
+// OrgRemoveMemberAuditEntryMembershipType is an alias of githubv4.OrgRemoveMemberAuditEntryMembershipType.
+type OrgRemoveMemberAuditEntryMembershipType = githubv4.OrgRemoveMemberAuditEntryMembershipType
+
+const (
+	OrgRemoveMemberAuditEntryMembershipTypeSuspended           = githubv4.OrgRemoveMemberAuditEntryMembershipTypeSuspended
+	OrgRemoveMemberAuditEntryMembershipTypeDirectMember        = githubv4.OrgRemoveMemberAuditEntryMembershipTypeDirectMember
+	OrgRemoveMemberAuditEntryMembershipTypeAdmin               = githubv4.OrgRemoveMemberAuditEntryMembershipTypeAdmin
+	OrgRemoveMemberAuditEntryMembershipTypeBillingManager      = githubv4.OrgRemoveMemberAuditEntryMembershipTypeBillingManager
+	OrgRemoveMemberAuditEntryMembershipTypeUnaffiliated        = githubv4.OrgRemoveMemberAuditEntryMembershipTypeUnaffiliated
+	OrgRemoveMemberAuditEntryMembershipTypeOutsideCollaborator = githubv4.OrgRemoveMemberAuditEntryMembershipTypeOutsideCollaborator
+)
+
+// OrgRemoveMemberAuditEntryReason is an alias of githubv4.OrgRemoveMemberAuditEntryReason.
+type OrgRemoveMemberAuditEntryReason = githubv4.OrgRemoveMemberAuditEntryReason
+
+const (
+	OrgRemoveMemberAuditEntryReasonTwoFactorRequirementNonCompliance          = githubv4.OrgRemoveMemberAuditEntryReasonTwoFactorRequirementNonCompliance
+	OrgRemoveMemberAuditEntryReasonSamlExternalIdentityMissing                = githubv4.OrgRemoveMemberAuditEntryReasonSamlExternalIdentityMissing
+	OrgRemoveMemberAuditEntryReasonSamlSsoEnforcementRequiresExternalIdentity = githubv4.OrgRemoveMemberAuditEntryReasonSamlSsoEnforcementRequiresExternalIdentity
+	OrgRemoveMemberAuditEntryReasonUserAccountDeleted                         = githubv4.OrgRemoveMemberAuditEntryReasonUserAccountDeleted
+	OrgRemoveMemberAuditEntryReasonTwoFactorAccountRecovery                   = githubv4.OrgRemoveMemberAuditEntryReasonTwoFactorAccountRecovery
+)
+
+// OrgRemoveOutsideCollaboratorAuditEntryMembershipType is an alias of githubv4.OrgRemoveOutsideCollaboratorAuditEntryMembershipType.
+type OrgRemoveOutsideCollaboratorAuditEntryMembershipType = githubv4.OrgRemoveOutsideCollaboratorAuditEntryMembershipType
+
+const (
+	OrgRemoveOutsideCollaboratorAuditEntryMembershipTypeOutsideCollaborator = githubv4.OrgRemoveOutsideCollaboratorAuditEntryMembershipTypeOutsideCollaborator
+	OrgRemoveOutsideCollaboratorAuditEntryMembershipTypeUnaffiliated        = githubv4.OrgRemoveOutsideCollaboratorAuditEntryMembershipTypeUnaffiliated
+	OrgRemoveOutsideCollaboratorAuditEntryMembershipTypeBillingManager      = githubv4.OrgRemoveOutsideCollaboratorAuditEntryMembershipTypeBillingManager
+)
+
+// OrgRemoveOutsideCollaboratorAuditEntryReason is an alias of githubv4.OrgRemoveOutsideCollaboratorAuditEntryReason.
+type OrgRemoveOutsideCollaboratorAuditEntryReason = githubv4.OrgRemoveOutsideCollaboratorAuditEntryReason
+
+const (
+	OrgRemoveOutsideCollaboratorAuditEntryReasonTwoFactorRequirementNonCompliance = githubv4.OrgRemoveOutsideCollaboratorAuditEntryReasonTwoFactorRequirementNonCompliance
+	OrgRemoveOutsideCollaboratorAuditEntryReasonSamlExternalIdentityMissing       = githubv4.OrgRemoveOutsideCollaboratorAuditEntryReasonSamlExternalIdentityMissing
+)
+
+// OrgUpdateDefaultRepositoryPermissionAuditEntryPermission is an alias of githubv4.OrgUpdateDefaultRepositoryPermissionAuditEntryPermission.
+type OrgUpdateDefaultRepositoryPermissionAuditEntryPermission = githubv4.OrgUpdateDefaultRepositoryPermissionAuditEntryPermission
+
+const (
+	OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionRead  = githubv4.OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionRead
+	OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionWrite = githubv4.OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionWrite
+	OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionAdmin = githubv4.OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionAdmin
+	OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionNone  = githubv4.OrgUpdateDefaultRepositoryPermissionAuditEntryPermissionNone
+)
+
+// OrgUpdateMemberAuditEntryPermission is an alias of githubv4.OrgUpdateMemberAuditEntryPermission.
+type OrgUpdateMemberAuditEntryPermission = githubv4.OrgUpdateMemberAuditEntryPermission
+
+const (
+	OrgUpdateMemberAuditEntryPermissionRead  = githubv4.OrgUpdateMemberAuditEntryPermissionRead
+	OrgUpdateMemberAuditEntryPermissionAdmin = githubv4.OrgUpdateMemberAuditEntryPermissionAdmin
+)
+
+// OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibility is an alias of githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibility.
+type OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibility = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibility
+
+const (
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityAll             = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityAll
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPublic          = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPublic
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityNone            = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityNone
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPrivate         = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPrivate
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityInternal        = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityInternal
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPublicInternal  = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPublicInternal
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPrivateInternal = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPrivateInternal
+	OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPublicPrivate   = githubv4.OrgUpdateMemberRepositoryCreationPermissionAuditEntryVisibilityPublicPrivate
+)
+
+// OrganizationInvitationRole is an alias of githubv4.OrganizationInvitationRole.
+type OrganizationInvitationRole = githubv4.OrganizationInvitationRole
+
+const (
+	OrganizationInvitationRoleDirectMember   = githubv4.OrganizationInvitationRoleDirectMember
+	OrganizationInvitationRoleAdmin          = githubv4.OrganizationInvitationRoleAdmin
+	OrganizationInvitationRoleBillingManager = githubv4.OrganizationInvitationRoleBillingManager
+	OrganizationInvitationRoleReinstate      = githubv4.OrganizationInvitationRoleReinstate
+)
+
+// OrganizationInvitationSource is an alias of githubv4.OrganizationInvitationSource.
+type OrganizationInvitationSource = githubv4.OrganizationInvitationSource
+
+const (
+	OrganizationInvitationSourceUnknown = githubv4.OrganizationInvitationSourceUnknown
+	OrganizationInvitationSourceMember  = githubv4.OrganizationInvitationSourceMember
+	OrganizationInvitationSourceScim    = githubv4.OrganizationInvitationSourceScim
+)
+
+// OrganizationInvitationType is an alias of githubv4.OrganizationInvitationType.
+type OrganizationInvitationType = githubv4.OrganizationInvitationType
+
+const (
+	OrganizationInvitationTypeUser  = githubv4.OrganizationInvitationTypeUser
+	OrganizationInvitationTypeEmail = githubv4.OrganizationInvitationTypeEmail
+)
+
+// OrganizationMemberRole is an alias of githubv4.OrganizationMemberRole.
+type OrganizationMemberRole = githubv4.OrganizationMemberRole
+
+const (
+	OrganizationMemberRoleMember = githubv4.OrganizationMemberRoleMember
+	OrganizationMemberRoleAdmin  = githubv4.OrganizationMemberRoleAdmin
+)
+
+// OrganizationMembersCanCreateRepositoriesSettingValue is an alias of githubv4.OrganizationMembersCanCreateRepositoriesSettingValue.
+type OrganizationMembersCanCreateRepositoriesSettingValue = githubv4.OrganizationMembersCanCreateRepositoriesSettingValue
+
+const (
+	OrganizationMembersCanCreateRepositoriesSettingValueAll      = githubv4.OrganizationMembersCanCreateRepositoriesSettingValueAll
+	OrganizationMembersCanCreateRepositoriesSettingValuePrivate  = githubv4.OrganizationMembersCanCreateRepositoriesSettingValuePrivate
+	OrganizationMembersCanCreateRepositoriesSettingValueInternal = githubv4.OrganizationMembersCanCreateRepositoriesSettingValueInternal
+	OrganizationMembersCanCreateRepositoriesSettingValueDisabled = githubv4.OrganizationMembersCanCreateRepositoriesSettingValueDisabled
+)
+
+// OrganizationMigrationState is an alias of githubv4.OrganizationMigrationState.
+type OrganizationMigrationState = githubv4.OrganizationMigrationState
+
+const (
+	OrganizationMigrationStateNotStarted        = githubv4.OrganizationMigrationStateNotStarted
+	OrganizationMigrationStateQueued            = githubv4.OrganizationMigrationStateQueued
+	OrganizationMigrationStateInProgress        = githubv4.OrganizationMigrationStateInProgress
+	OrganizationMigrationStatePreRepoMigration  = githubv4.OrganizationMigrationStatePreRepoMigration
+	OrganizationMigrationStateRepoMigration     = githubv4.OrganizationMigrationStateRepoMigration
+	OrganizationMigrationStatePostRepoMigration = githubv4.OrganizationMigrationStatePostRepoMigration
+	OrganizationMigrationStateSucceeded         = githubv4.OrganizationMigrationStateSucceeded
+	OrganizationMigrationStateFailed            = githubv4.OrganizationMigrationStateFailed
+	OrganizationMigrationStatePendingValidation = githubv4.OrganizationMigrationStatePendingValidation
+	OrganizationMigrationStateFailedValidation  = githubv4.OrganizationMigrationStateFailedValidation
+)
+
+// OrganizationOrder is an alias of githubv4.OrganizationOrder.
+type OrganizationOrder = githubv4.OrganizationOrder
+
+// OrganizationOrderField is an alias of githubv4.OrganizationOrderField.
+type OrganizationOrderField = githubv4.OrganizationOrderField
+
+const (
+	OrganizationOrderFieldCreatedAt = githubv4.OrganizationOrderFieldCreatedAt
+	OrganizationOrderFieldLogin     = githubv4.OrganizationOrderFieldLogin
+)
+
+// PackageFileOrder is an alias of githubv4.PackageFileOrder.
+type PackageFileOrder = githubv4.PackageFileOrder
+
+// PackageFileOrderField is an alias of githubv4.PackageFileOrderField.
+type PackageFileOrderField = githubv4.PackageFileOrderField
+
+const (
+	PackageFileOrderFieldCreatedAt = githubv4.PackageFileOrderFieldCreatedAt
+)
+
+// PackageOrder is an alias of githubv4.PackageOrder.
+type PackageOrder = githubv4.PackageOrder
+
+// PackageOrderField is an alias of githubv4.PackageOrderField.
+type PackageOrderField = githubv4.PackageOrderField
+
+const (
+	PackageOrderFieldCreatedAt = githubv4.PackageOrderFieldCreatedAt
+)
+
+// PackageType is an alias of githubv4.PackageType.
+type PackageType = githubv4.PackageType
+
+const (
+	PackageTypeNpm      = githubv4.PackageTypeNpm
+	PackageTypeRubygems = githubv4.PackageTypeRubygems
+	PackageTypeMaven    = githubv4.PackageTypeMaven
+	PackageTypeDocker   = githubv4.PackageTypeDocker
+	PackageTypeDebian   = githubv4.PackageTypeDebian
+	PackageTypeNuget    = githubv4.PackageTypeNuget
+	PackageTypePypi     = githubv4.PackageTypePypi
+)
+
+// PackageVersionOrder is an alias of githubv4.PackageVersionOrder.
+type PackageVersionOrder = githubv4.PackageVersionOrder
+
+// PackageVersionOrderField is an alias of githubv4.PackageVersionOrderField.
+type PackageVersionOrderField = githubv4.PackageVersionOrderField
+
+const (
+	PackageVersionOrderFieldCreatedAt = githubv4.PackageVersionOrderFieldCreatedAt
+)
+
+// PatchStatus is an alias of githubv4.PatchStatus.
+type PatchStatus = githubv4.PatchStatus
+
+const (
+	PatchStatusAdded    = githubv4.PatchStatusAdded
+	PatchStatusDeleted  = githubv4.PatchStatusDeleted
+	PatchStatusRenamed  = githubv4.PatchStatusRenamed
+	PatchStatusCopied   = githubv4.PatchStatusCopied
+	PatchStatusModified = githubv4.PatchStatusModified
+	PatchStatusChanged  = githubv4.PatchStatusChanged
+)
+
+// PinIssueInput is an alias of githubv4.PinIssueInput.
+type PinIssueInput = githubv4.PinIssueInput
+
+// PinnableItemType is an alias of githubv4.PinnableItemType.
+type PinnableItemType = githubv4.PinnableItemType
+
+const (
+	PinnableItemTypeRepository   = githubv4.PinnableItemTypeRepository
+	PinnableItemTypeGist         = githubv4.PinnableItemTypeGist
+	PinnableItemTypeIssue        = githubv4.PinnableItemTypeIssue
+	PinnableItemTypeProject      = githubv4.PinnableItemTypeProject
+	PinnableItemTypePullRequest  = githubv4.PinnableItemTypePullRequest
+	PinnableItemTypeUser         = githubv4.PinnableItemTypeUser
+	PinnableItemTypeOrganization = githubv4.PinnableItemTypeOrganization
+	PinnableItemTypeTeam         = githubv4.PinnableItemTypeTeam
+)
+
+// PinnedDiscussionGradient is an alias of githubv4.PinnedDiscussionGradient.
+type PinnedDiscussionGradient = githubv4.PinnedDiscussionGradient
+
+const (
+	PinnedDiscussionGradientRedOrange   = githubv4.PinnedDiscussionGradientRedOrange
+	PinnedDiscussionGradientBlueMint    = githubv4.PinnedDiscussionGradientBlueMint
+	PinnedDiscussionGradientBluePurple  = githubv4.PinnedDiscussionGradientBluePurple
+	PinnedDiscussionGradientPinkBlue    = githubv4.PinnedDiscussionGradientPinkBlue
+	PinnedDiscussionGradientPurpleCoral = githubv4.PinnedDiscussionGradientPurpleCoral
+)
+
+// PinnedDiscussionPattern is an alias of githubv4.PinnedDiscussionPattern.
+type PinnedDiscussionPattern = githubv4.PinnedDiscussionPattern
+
+const (
+	PinnedDiscussionPatternDotFill   = githubv4.PinnedDiscussionPatternDotFill
+	PinnedDiscussionPatternPlus      = githubv4.PinnedDiscussionPatternPlus
+	PinnedDiscussionPatternZap       = githubv4.PinnedDiscussionPatternZap
+	PinnedDiscussionPatternChevronUp = githubv4.PinnedDiscussionPatternChevronUp
+	PinnedDiscussionPatternDot       = githubv4.PinnedDiscussionPatternDot
+	PinnedDiscussionPatternHeartFill = githubv4.PinnedDiscussionPatternHeartFill
+)
+
+// ProjectCardArchivedState is an alias of githubv4.ProjectCardArchivedState.
+type ProjectCardArchivedState = githubv4.ProjectCardArchivedState
+
+const (
+	ProjectCardArchivedStateArchived    = githubv4.ProjectCardArchivedStateArchived
+	ProjectCardArchivedStateNotArchived = githubv4.ProjectCardArchivedStateNotArchived
+)
+
+// ProjectCardState is an alias of githubv4.ProjectCardState.
+type ProjectCardState = githubv4.ProjectCardState
+
+const (
+	ProjectCardStateContentOnly = githubv4.ProjectCardStateContentOnly
+	ProjectCardStateNoteOnly    = githubv4.ProjectCardStateNoteOnly
+	ProjectCardStateRedacted    = githubv4.ProjectCardStateRedacted
+)
+
+// ProjectColumnPurpose is an alias of githubv4.ProjectColumnPurpose.
+type ProjectColumnPurpose = githubv4.ProjectColumnPurpose
+
+const (
+	ProjectColumnPurposeTodo       = githubv4.ProjectColumnPurposeTodo
+	ProjectColumnPurposeInProgress = githubv4.ProjectColumnPurposeInProgress
+	ProjectColumnPurposeDone       = githubv4.ProjectColumnPurposeDone
+)
+
+// ProjectOrder is an alias of githubv4.ProjectOrder.
+type ProjectOrder = githubv4.ProjectOrder
+
+// ProjectOrderField is an alias of githubv4.ProjectOrderField.
+type ProjectOrderField = githubv4.ProjectOrderField
+
+const (
+	ProjectOrderFieldCreatedAt = githubv4.ProjectOrderFieldCreatedAt
+	ProjectOrderFieldUpdatedAt = githubv4.ProjectOrderFieldUpdatedAt
+	ProjectOrderFieldName      = githubv4.ProjectOrderFieldName
+)
+
+// ProjectState is an alias of githubv4.ProjectState.
+type ProjectState = githubv4.ProjectState
+
+const (
+	ProjectStateOpen   = githubv4.ProjectStateOpen
+	ProjectStateClosed = githubv4.ProjectStateClosed
+)
+
+// ProjectTemplate is an alias of githubv4.ProjectTemplate.
+type ProjectTemplate = githubv4.ProjectTemplate
+
+const (
+	ProjectTemplateBasicKanban            = githubv4.ProjectTemplateBasicKanban
+	ProjectTemplateAutomatedKanbanV2      = githubv4.ProjectTemplateAutomatedKanbanV2
+	ProjectTemplateAutomatedReviewsKanban = githubv4.ProjectTemplateAutomatedReviewsKanban
+	ProjectTemplateBugTriage              = githubv4.ProjectTemplateBugTriage
+)
+
+// ProjectV2Collaborator is an alias of githubv4.ProjectV2Collaborator.
+type ProjectV2Collaborator = githubv4.ProjectV2Collaborator
+
+// ProjectV2CustomFieldType is an alias of githubv4.ProjectV2CustomFieldType.
+type ProjectV2CustomFieldType = githubv4.ProjectV2CustomFieldType
+
+const (
+	ProjectV2CustomFieldTypeText         = githubv4.ProjectV2CustomFieldTypeText
+	ProjectV2CustomFieldTypeSingleSelect = githubv4.ProjectV2CustomFieldTypeSingleSelect
+	ProjectV2CustomFieldTypeNumber       = githubv4.ProjectV2CustomFieldTypeNumber
+	ProjectV2CustomFieldTypeDate         = githubv4.ProjectV2CustomFieldTypeDate
+)
+
+// ProjectV2FieldOrder is an alias of githubv4.ProjectV2FieldOrder.
+type ProjectV2FieldOrder = githubv4.ProjectV2FieldOrder
+
+// ProjectV2FieldOrderField is an alias of githubv4.ProjectV2FieldOrderField.
+type ProjectV2FieldOrderField = githubv4.ProjectV2FieldOrderField
+
+const (
+	ProjectV2FieldOrderFieldPosition  = githubv4.ProjectV2FieldOrderFieldPosition
+	ProjectV2FieldOrderFieldCreatedAt = githubv4.ProjectV2FieldOrderFieldCreatedAt
+	ProjectV2FieldOrderFieldName      = githubv4.ProjectV2FieldOrderFieldName
+)
+
+// ProjectV2FieldType is an alias of githubv4.ProjectV2FieldType.
+type ProjectV2FieldType = githubv4.ProjectV2FieldType
+
+const (
+	ProjectV2FieldTypeAssignees          = githubv4.ProjectV2FieldTypeAssignees
+	ProjectV2FieldTypeLinkedPullRequests = githubv4.ProjectV2FieldTypeLinkedPullRequests
+	ProjectV2FieldTypeReviewers          = githubv4.ProjectV2FieldTypeReviewers
+	ProjectV2FieldTypeLabels             = githubv4.ProjectV2FieldTypeLabels
+	ProjectV2FieldTypeMilestone          = githubv4.ProjectV2FieldTypeMilestone
+	ProjectV2FieldTypeRepository         = githubv4.ProjectV2FieldTypeRepository
+	ProjectV2FieldTypeTitle              = githubv4.ProjectV2FieldTypeTitle
+	ProjectV2FieldTypeText               = githubv4.ProjectV2FieldTypeText
+	ProjectV2FieldTypeSingleSelect       = githubv4.ProjectV2FieldTypeSingleSelect
+	ProjectV2FieldTypeNumber             = githubv4.ProjectV2FieldTypeNumber
+	ProjectV2FieldTypeDate               = githubv4.ProjectV2FieldTypeDate
+	ProjectV2FieldTypeIteration          = githubv4.ProjectV2FieldTypeIteration
+	ProjectV2FieldTypeTracks             = githubv4.ProjectV2FieldTypeTracks
+	ProjectV2FieldTypeTrackedBy          = githubv4.ProjectV2FieldTypeTrackedBy
+)
+
+// ProjectV2FieldValue is an alias of githubv4.ProjectV2FieldValue.
+type ProjectV2FieldValue = githubv4.ProjectV2FieldValue
+
+// ProjectV2Filters is an alias of githubv4.ProjectV2Filters.
+type ProjectV2Filters = githubv4.ProjectV2Filters
+
+// ProjectV2ItemFieldValueOrder is an alias of githubv4.ProjectV2ItemFieldValueOrder.
+type ProjectV2ItemFieldValueOrder = githubv4.ProjectV2ItemFieldValueOrder
+
+// ProjectV2ItemFieldValueOrderField is an alias of githubv4.ProjectV2ItemFieldValueOrderField.
+type ProjectV2ItemFieldValueOrderField = githubv4.ProjectV2ItemFieldValueOrderField
+
+const (
+	ProjectV2ItemFieldValueOrderFieldPosition = githubv4.ProjectV2ItemFieldValueOrderFieldPosition
+)
+
+// ProjectV2ItemOrder is an alias of githubv4.ProjectV2ItemOrder.
+type ProjectV2ItemOrder = githubv4.ProjectV2ItemOrder
+
+// ProjectV2ItemOrderField is an alias of githubv4.ProjectV2ItemOrderField.
+type ProjectV2ItemOrderField = githubv4.ProjectV2ItemOrderField
+
+const (
+	ProjectV2ItemOrderFieldPosition = githubv4.ProjectV2ItemOrderFieldPosition
+)
+
+// ProjectV2ItemType is an alias of githubv4.ProjectV2ItemType.
+type ProjectV2ItemType = githubv4.ProjectV2ItemType
+
+const (
+	ProjectV2ItemTypeIssue       = githubv4.ProjectV2ItemTypeIssue
+	ProjectV2ItemTypePullRequest = githubv4.ProjectV2ItemTypePullRequest
+	ProjectV2ItemTypeDraftIssue  = githubv4.ProjectV2ItemTypeDraftIssue
+	ProjectV2ItemTypeRedacted    = githubv4.ProjectV2ItemTypeRedacted
+)
+
+// ProjectV2Order is an alias of githubv4.ProjectV2Order.
+type ProjectV2Order = githubv4.ProjectV2Order
+
+// ProjectV2OrderField is an alias of githubv4.ProjectV2OrderField.
+type ProjectV2OrderField = githubv4.ProjectV2OrderField
+
+const (
+	ProjectV2OrderFieldTitle     = githubv4.ProjectV2OrderFieldTitle
+	ProjectV2OrderFieldNumber    = githubv4.ProjectV2OrderFieldNumber
+	ProjectV2OrderFieldUpdatedAt = githubv4.ProjectV2OrderFieldUpdatedAt
+	ProjectV2OrderFieldCreatedAt = githubv4.ProjectV2OrderFieldCreatedAt
+)
+
+// ProjectV2Roles is an alias of githubv4.ProjectV2Roles.
+type ProjectV2Roles = githubv4.ProjectV2Roles
+
+const (
+	ProjectV2RolesNone   = githubv4.ProjectV2RolesNone
+	ProjectV2RolesReader = githubv4.ProjectV2RolesReader
+	ProjectV2RolesWriter = githubv4.ProjectV2RolesWriter
+	ProjectV2RolesAdmin  = githubv4.ProjectV2RolesAdmin
+)
+
+// ProjectV2SingleSelectFieldOptionColor is an alias of githubv4.ProjectV2SingleSelectFieldOptionColor.
+type ProjectV2SingleSelectFieldOptionColor = githubv4.ProjectV2SingleSelectFieldOptionColor
+
+const (
+	ProjectV2SingleSelectFieldOptionColorGray   = githubv4.ProjectV2SingleSelectFieldOptionColorGray
+	ProjectV2SingleSelectFieldOptionColorBlue   = githubv4.ProjectV2SingleSelectFieldOptionColorBlue
+	ProjectV2SingleSelectFieldOptionColorGreen  = githubv4.ProjectV2SingleSelectFieldOptionColorGreen
+	ProjectV2SingleSelectFieldOptionColorYellow = githubv4.ProjectV2SingleSelectFieldOptionColorYellow
+	ProjectV2SingleSelectFieldOptionColorOrange = githubv4.ProjectV2SingleSelectFieldOptionColorOrange
+	ProjectV2SingleSelectFieldOptionColorRed    = githubv4.ProjectV2SingleSelectFieldOptionColorRed
+	ProjectV2SingleSelectFieldOptionColorPink   = githubv4.ProjectV2SingleSelectFieldOptionColorPink
+	ProjectV2SingleSelectFieldOptionColorPurple = githubv4.ProjectV2SingleSelectFieldOptionColorPurple
+)
+
+// ProjectV2SingleSelectFieldOptionInput is an alias of githubv4.ProjectV2SingleSelectFieldOptionInput.
+type ProjectV2SingleSelectFieldOptionInput = githubv4.ProjectV2SingleSelectFieldOptionInput
+
+// ProjectV2State is an alias of githubv4.ProjectV2State.
+type ProjectV2State = githubv4.ProjectV2State
+
+const (
+	ProjectV2StateOpen   = githubv4.ProjectV2StateOpen
+	ProjectV2StateClosed = githubv4.ProjectV2StateClosed
+)
+
+// ProjectV2ViewLayout is an alias of githubv4.ProjectV2ViewLayout.
+type ProjectV2ViewLayout = githubv4.ProjectV2ViewLayout
+
+const (
+	ProjectV2ViewLayoutBoardLayout   = githubv4.ProjectV2ViewLayoutBoardLayout
+	ProjectV2ViewLayoutTableLayout   = githubv4.ProjectV2ViewLayoutTableLayout
+	ProjectV2ViewLayoutRoadmapLayout = githubv4.ProjectV2ViewLayoutRoadmapLayout
+)
+
+// ProjectV2ViewOrder is an alias of githubv4.ProjectV2ViewOrder.
+type ProjectV2ViewOrder = githubv4.ProjectV2ViewOrder
+
+// ProjectV2ViewOrderField is an alias of githubv4.ProjectV2ViewOrderField.
+type ProjectV2ViewOrderField = githubv4.ProjectV2ViewOrderField
+
+const (
+	ProjectV2ViewOrderFieldPosition  = githubv4.ProjectV2ViewOrderFieldPosition
+	ProjectV2ViewOrderFieldCreatedAt = githubv4.ProjectV2ViewOrderFieldCreatedAt
+	ProjectV2ViewOrderFieldName      = githubv4.ProjectV2ViewOrderFieldName
+)
+
+// ProjectV2WorkflowOrder is an alias of githubv4.ProjectV2WorkflowOrder.
+type ProjectV2WorkflowOrder = githubv4.ProjectV2WorkflowOrder
+
+// ProjectV2WorkflowsOrderField is an alias of githubv4.ProjectV2WorkflowsOrderField.
+type ProjectV2WorkflowsOrderField = githubv4.ProjectV2WorkflowsOrderField
+
+const (
+	ProjectV2WorkflowsOrderFieldName      = githubv4.ProjectV2WorkflowsOrderFieldName
+	ProjectV2WorkflowsOrderFieldNumber    = githubv4.ProjectV2WorkflowsOrderFieldNumber
+	ProjectV2WorkflowsOrderFieldUpdatedAt = githubv4.ProjectV2WorkflowsOrderFieldUpdatedAt
+	ProjectV2WorkflowsOrderFieldCreatedAt = githubv4.ProjectV2WorkflowsOrderFieldCreatedAt
+)
+
+// PublishSponsorsTierInput is an alias of githubv4.PublishSponsorsTierInput.
+type PublishSponsorsTierInput = githubv4.PublishSponsorsTierInput
+
+// PullRequestBranchUpdateMethod is an alias of githubv4.PullRequestBranchUpdateMethod.
+type PullRequestBranchUpdateMethod = githubv4.PullRequestBranchUpdateMethod
+
+const (
+	PullRequestBranchUpdateMethodMerge  = githubv4.PullRequestBranchUpdateMethodMerge
+	PullRequestBranchUpdateMethodRebase = githubv4.PullRequestBranchUpdateMethodRebase
+)
+
+// PullRequestMergeMethod is an alias of githubv4.PullRequestMergeMethod.
+type PullRequestMergeMethod = githubv4.PullRequestMergeMethod
+
+const (
+	PullRequestMergeMethodMerge  = githubv4.PullRequestMergeMethodMerge
+	PullRequestMergeMethodSquash = githubv4.PullRequestMergeMethodSquash
+	PullRequestMergeMethodRebase = githubv4.PullRequestMergeMethodRebase
+)
+
+// PullRequestOrder is an alias of githubv4.PullRequestOrder.
+type PullRequestOrder = githubv4.PullRequestOrder
+
+// PullRequestOrderField is an alias of githubv4.PullRequestOrderField.
+type PullRequestOrderField = githubv4.PullRequestOrderField
+
+const (
+	PullRequestOrderFieldCreatedAt = githubv4.PullRequestOrderFieldCreatedAt
+	PullRequestOrderFieldUpdatedAt = githubv4.PullRequestOrderFieldUpdatedAt
+)
+
+// PullRequestParametersInput is an alias of githubv4.PullRequestParametersInput.
+type PullRequestParametersInput = githubv4.PullRequestParametersInput
+
+// PullRequestReviewCommentState is an alias of githubv4.PullRequestReviewCommentState.
+type PullRequestReviewCommentState = githubv4.PullRequestReviewCommentState
+
+const (
+	PullRequestReviewCommentStatePending   = githubv4.PullRequestReviewCommentStatePending
+	PullRequestReviewCommentStateSubmitted = githubv4.PullRequestReviewCommentStateSubmitted
+)
+
+// PullRequestReviewDecision is an alias of githubv4.PullRequestReviewDecision.
+type PullRequestReviewDecision = githubv4.PullRequestReviewDecision
+
+const (
+	PullRequestReviewDecisionChangesRequested = githubv4.PullRequestReviewDecisionChangesRequested
+	PullRequestReviewDecisionApproved         = githubv4.PullRequestReviewDecisionApproved
+	PullRequestReviewDecisionReviewRequired   = githubv4.PullRequestReviewDecisionReviewRequired
+)
+
+// PullRequestReviewEvent is an alias of githubv4.PullRequestReviewEvent.
+type PullRequestReviewEvent = githubv4.PullRequestReviewEvent
+
+const (
+	PullRequestReviewEventComment        = githubv4.PullRequestReviewEventComment
+	PullRequestReviewEventApprove        = githubv4.PullRequestReviewEventApprove
+	PullRequestReviewEventRequestChanges = githubv4.PullRequestReviewEventRequestChanges
+	PullRequestReviewEventDismiss        = githubv4.PullRequestReviewEventDismiss
+)
+
+// PullRequestReviewState is an alias of githubv4.PullRequestReviewState.
+type PullRequestReviewState = githubv4.PullRequestReviewState
+
+const (
+	PullRequestReviewStatePending          = githubv4.PullRequestReviewStatePending
+	PullRequestReviewStateCommented        = githubv4.PullRequestReviewStateCommented
+	PullRequestReviewStateApproved         = githubv4.PullRequestReviewStateApproved
+	PullRequestReviewStateChangesRequested = githubv4.PullRequestReviewStateChangesRequested
+	PullRequestReviewStateDismissed        = githubv4.PullRequestReviewStateDismissed
+)
+
+// PullRequestReviewThreadSubjectType is an alias of githubv4.PullRequestReviewThreadSubjectType.
+type PullRequestReviewThreadSubjectType = githubv4.PullRequestReviewThreadSubjectType
+
+const (
+	PullRequestReviewThreadSubjectTypeLine = githubv4.PullRequestReviewThreadSubjectTypeLine
+	PullRequestReviewThreadSubjectTypeFile = githubv4.PullRequestReviewThreadSubjectTypeFile
+)
+
+// PullRequestState is an alias of githubv4.PullRequestState.
+type PullRequestState = githubv4.PullRequestState
+
+const (
+	PullRequestStateOpen   = githubv4.PullRequestStateOpen
+	PullRequestStateClosed = githubv4.PullRequestStateClosed
+	PullRequestStateMerged = githubv4.PullRequestStateMerged
+)
+
+// PullRequestTimelineItemsItemType is an alias of githubv4.PullRequestTimelineItemsItemType.
+type PullRequestTimelineItemsItemType = githubv4.PullRequestTimelineItemsItemType
+
+const (
+	PullRequestTimelineItemsItemTypePullRequestCommit                 = githubv4.PullRequestTimelineItemsItemTypePullRequestCommit
+	PullRequestTimelineItemsItemTypePullRequestCommitCommentThread    = githubv4.PullRequestTimelineItemsItemTypePullRequestCommitCommentThread
+	PullRequestTimelineItemsItemTypePullRequestReview                 = githubv4.PullRequestTimelineItemsItemTypePullRequestReview
+	PullRequestTimelineItemsItemTypePullRequestReviewThread           = githubv4.PullRequestTimelineItemsItemTypePullRequestReviewThread
+	PullRequestTimelineItemsItemTypePullRequestRevisionMarker         = githubv4.PullRequestTimelineItemsItemTypePullRequestRevisionMarker
+	PullRequestTimelineItemsItemTypeAutomaticBaseChangeFailedEvent    = githubv4.PullRequestTimelineItemsItemTypeAutomaticBaseChangeFailedEvent
+	PullRequestTimelineItemsItemTypeAutomaticBaseChangeSucceededEvent = githubv4.PullRequestTimelineItemsItemTypeAutomaticBaseChangeSucceededEvent
+	PullRequestTimelineItemsItemTypeAutoMergeDisabledEvent            = githubv4.PullRequestTimelineItemsItemTypeAutoMergeDisabledEvent
+	PullRequestTimelineItemsItemTypeAutoMergeEnabledEvent             = githubv4.PullRequestTimelineItemsItemTypeAutoMergeEnabledEvent
+	PullRequestTimelineItemsItemTypeAutoRebaseEnabledEvent            = githubv4.PullRequestTimelineItemsItemTypeAutoRebaseEnabledEvent
+	PullRequestTimelineItemsItemTypeAutoSquashEnabledEvent            = githubv4.PullRequestTimelineItemsItemTypeAutoSquashEnabledEvent
+	PullRequestTimelineItemsItemTypeBaseRefChangedEvent               = githubv4.PullRequestTimelineItemsItemTypeBaseRefChangedEvent
+	PullRequestTimelineItemsItemTypeBaseRefForcePushedEvent           = githubv4.PullRequestTimelineItemsItemTypeBaseRefForcePushedEvent
+	PullRequestTimelineItemsItemTypeBaseRefDeletedEvent               = githubv4.PullRequestTimelineItemsItemTypeBaseRefDeletedEvent
+	PullRequestTimelineItemsItemTypeDeployedEvent                     = githubv4.PullRequestTimelineItemsItemTypeDeployedEvent
+	PullRequestTimelineItemsItemTypeDeploymentEnvironmentChangedEvent = githubv4.PullRequestTimelineItemsItemTypeDeploymentEnvironmentChangedEvent
+	PullRequestTimelineItemsItemTypeHeadRefDeletedEvent               = githubv4.PullRequestTimelineItemsItemTypeHeadRefDeletedEvent
+	PullRequestTimelineItemsItemTypeHeadRefForcePushedEvent           = githubv4.PullRequestTimelineItemsItemTypeHeadRefForcePushedEvent
+	PullRequestTimelineItemsItemTypeHeadRefRestoredEvent              = githubv4.PullRequestTimelineItemsItemTypeHeadRefRestoredEvent
+	PullRequestTimelineItemsItemTypeMergedEvent                       = githubv4.PullRequestTimelineItemsItemTypeMergedEvent
+	PullRequestTimelineItemsItemTypeReviewDismissedEvent              = githubv4.PullRequestTimelineItemsItemTypeReviewDismissedEvent
+	PullRequestTimelineItemsItemTypeReviewRequestedEvent              = githubv4.PullRequestTimelineItemsItemTypeReviewRequestedEvent
+	PullRequestTimelineItemsItemTypeReviewRequestRemovedEvent         = githubv4.PullRequestTimelineItemsItemTypeReviewRequestRemovedEvent
+	PullRequestTimelineItemsItemTypeReadyForReviewEvent               = githubv4.PullRequestTimelineItemsItemTypeReadyForReviewEvent
+	PullRequestTimelineItemsItemTypeConvertToDraftEvent               = githubv4.PullRequestTimelineItemsItemTypeConvertToDraftEvent
+	PullRequestTimelineItemsItemTypeAddedToMergeQueueEvent            = githubv4.PullRequestTimelineItemsItemTypeAddedToMergeQueueEvent
+	PullRequestTimelineItemsItemTypeRemovedFromMergeQueueEvent        = githubv4.PullRequestTimelineItemsItemTypeRemovedFromMergeQueueEvent
+	PullRequestTimelineItemsItemTypeIssueComment                      = githubv4.PullRequestTimelineItemsItemTypeIssueComment
+	PullRequestTimelineItemsItemTypeCrossReferencedEvent              = githubv4.PullRequestTimelineItemsItemTypeCrossReferencedEvent
+	PullRequestTimelineItemsItemTypeAddedToProjectEvent               = githubv4.PullRequestTimelineItemsItemTypeAddedToProjectEvent
+	PullRequestTimelineItemsItemTypeAssignedEvent                     = githubv4.PullRequestTimelineItemsItemTypeAssignedEvent
+	PullRequestTimelineItemsItemTypeClosedEvent                       = githubv4.PullRequestTimelineItemsItemTypeClosedEvent
+	PullRequestTimelineItemsItemTypeCommentDeletedEvent               = githubv4.PullRequestTimelineItemsItemTypeCommentDeletedEvent
+	PullRequestTimelineItemsItemTypeConnectedEvent                    = githubv4.PullRequestTimelineItemsItemTypeConnectedEvent
+	PullRequestTimelineItemsItemTypeConvertedNoteToIssueEvent         = githubv4.PullRequestTimelineItemsItemTypeConvertedNoteToIssueEvent
+	PullRequestTimelineItemsItemTypeConvertedToDiscussionEvent        = githubv4.PullRequestTimelineItemsItemTypeConvertedToDiscussionEvent
+	PullRequestTimelineItemsItemTypeDemilestonedEvent                 = githubv4.PullRequestTimelineItemsItemTypeDemilestonedEvent
+	PullRequestTimelineItemsItemTypeDisconnectedEvent                 = githubv4.PullRequestTimelineItemsItemTypeDisconnectedEvent
+	PullRequestTimelineItemsItemTypeLabeledEvent                      = githubv4.PullRequestTimelineItemsItemTypeLabeledEvent
+	PullRequestTimelineItemsItemTypeLockedEvent                       = githubv4.PullRequestTimelineItemsItemTypeLockedEvent
+	PullRequestTimelineItemsItemTypeMarkedAsDuplicateEvent            = githubv4.PullRequestTimelineItemsItemTypeMarkedAsDuplicateEvent
+	PullRequestTimelineItemsItemTypeMentionedEvent                    = githubv4.PullRequestTimelineItemsItemTypeMentionedEvent
+	PullRequestTimelineItemsItemTypeMilestonedEvent                   = githubv4.PullRequestTimelineItemsItemTypeMilestonedEvent
+	PullRequestTimelineItemsItemTypeMovedColumnsInProjectEvent        = githubv4.PullRequestTimelineItemsItemTypeMovedColumnsInProjectEvent
+	PullRequestTimelineItemsItemTypePinnedEvent                       = githubv4.PullRequestTimelineItemsItemTypePinnedEvent
+	PullRequestTimelineItemsItemTypeReferencedEvent                   = githubv4.PullRequestTimelineItemsItemTypeReferencedEvent
+	PullRequestTimelineItemsItemTypeRemovedFromProjectEvent           = githubv4.PullRequestTimelineItemsItemTypeRemovedFromProjectEvent
+	PullRequestTimelineItemsItemTypeRenamedTitleEvent                 = githubv4.PullRequestTimelineItemsItemTypeRenamedTitleEvent
+	PullRequestTimelineItemsItemTypeReopenedEvent                     = githubv4.PullRequestTimelineItemsItemTypeReopenedEvent
+	PullRequestTimelineItemsItemTypeSubscribedEvent                   = githubv4.PullRequestTimelineItemsItemTypeSubscribedEvent
+	PullRequestTimelineItemsItemTypeTransferredEvent                  = githubv4.PullRequestTimelineItemsItemTypeTransferredEvent
+	PullRequestTimelineItemsItemTypeUnassignedEvent                   = githubv4.PullRequestTimelineItemsItemTypeUnassignedEvent
+	PullRequestTimelineItemsItemTypeUnlabeledEvent                    = githubv4.PullRequestTimelineItemsItemTypeUnlabeledEvent
+	PullRequestTimelineItemsItemTypeUnlockedEvent                     = githubv4.PullRequestTimelineItemsItemTypeUnlockedEvent
+	PullRequestTimelineItemsItemTypeUserBlockedEvent                  = githubv4.PullRequestTimelineItemsItemTypeUserBlockedEvent
+	PullRequestTimelineItemsItemTypeUnmarkedAsDuplicateEvent          = githubv4.PullRequestTimelineItemsItemTypeUnmarkedAsDuplicateEvent
+	PullRequestTimelineItemsItemTypeUnpinnedEvent                     = githubv4.PullRequestTimelineItemsItemTypeUnpinnedEvent
+	PullRequestTimelineItemsItemTypeUnsubscribedEvent                 = githubv4.PullRequestTimelineItemsItemTypeUnsubscribedEvent
+)
+
+// PullRequestUpdateState is an alias of githubv4.PullRequestUpdateState.
+type PullRequestUpdateState = githubv4.PullRequestUpdateState
+
+const (
+	PullRequestUpdateStateOpen   = githubv4.PullRequestUpdateStateOpen
+	PullRequestUpdateStateClosed = githubv4.PullRequestUpdateStateClosed
+)
+
+// ReactionContent is an alias of githubv4.ReactionContent.
+type ReactionContent = githubv4.ReactionContent
+
+const (
+	ReactionContentThumbsUp   = githubv4.ReactionContentThumbsUp
+	ReactionContentThumbsDown = githubv4.ReactionContentThumbsDown
+	ReactionContentLaugh      = githubv4.ReactionContentLaugh
+	ReactionContentHooray     = githubv4.ReactionContentHooray
+	ReactionContentConfused   = githubv4.ReactionContentConfused
+	ReactionContentHeart      = githubv4.ReactionContentHeart
+	ReactionContentRocket     = githubv4.ReactionContentRocket
+	ReactionContentEyes       = githubv4.ReactionContentEyes
+)
+
+// ReactionOrder is an alias of githubv4.ReactionOrder.
+type ReactionOrder = githubv4.ReactionOrder
+
+// ReactionOrderField is an alias of githubv4.ReactionOrderField.
+type ReactionOrderField = githubv4.ReactionOrderField
+
+const (
+	ReactionOrderFieldCreatedAt = githubv4.ReactionOrderFieldCreatedAt
+)
+
+// RefNameConditionTargetInput is an alias of githubv4.RefNameConditionTargetInput.
+type RefNameConditionTargetInput = githubv4.RefNameConditionTargetInput
+
+// RefOrder is an alias of githubv4.RefOrder.
+type RefOrder = githubv4.RefOrder
+
+// RefOrderField is an alias of githubv4.RefOrderField.
+type RefOrderField = githubv4.RefOrderField
+
+const (
+	RefOrderFieldTagCommitDate = githubv4.RefOrderFieldTagCommitDate
+	RefOrderFieldAlphabetical  = githubv4.RefOrderFieldAlphabetical
+)
+
+// RegenerateEnterpriseIdentityProviderRecoveryCodesInput is an alias of githubv4.RegenerateEnterpriseIdentityProviderRecoveryCodesInput.
+type RegenerateEnterpriseIdentityProviderRecoveryCodesInput = githubv4.RegenerateEnterpriseIdentityProviderRecoveryCodesInput
+
+// RegenerateVerifiableDomainTokenInput is an alias of githubv4.RegenerateVerifiableDomainTokenInput.
+type RegenerateVerifiableDomainTokenInput = githubv4.RegenerateVerifiableDomainTokenInput
+
+// RejectDeploymentsInput is an alias of githubv4.RejectDeploymentsInput.
+type RejectDeploymentsInput = githubv4.RejectDeploymentsInput
+
+// ReleaseOrder is an alias of githubv4.ReleaseOrder.
+type ReleaseOrder = githubv4.ReleaseOrder
+
+// ReleaseOrderField is an alias of githubv4.ReleaseOrderField.
+type ReleaseOrderField = githubv4.ReleaseOrderField
+
+const (
+	ReleaseOrderFieldCreatedAt = githubv4.ReleaseOrderFieldCreatedAt
+	ReleaseOrderFieldName      = githubv4.ReleaseOrderFieldName
+)
+
+// RemoveAssigneesFromAssignableInput is an alias of githubv4.RemoveAssigneesFromAssignableInput.
+type RemoveAssigneesFromAssignableInput = githubv4.RemoveAssigneesFromAssignableInput
+
+// RemoveEnterpriseAdminInput is an alias of githubv4.RemoveEnterpriseAdminInput.
+type RemoveEnterpriseAdminInput = githubv4.RemoveEnterpriseAdminInput
+
+// RemoveEnterpriseIdentityProviderInput is an alias of githubv4.RemoveEnterpriseIdentityProviderInput.
+type RemoveEnterpriseIdentityProviderInput = githubv4.RemoveEnterpriseIdentityProviderInput
+
+// RemoveEnterpriseMemberInput is an alias of githubv4.RemoveEnterpriseMemberInput.
+type RemoveEnterpriseMemberInput = githubv4.RemoveEnterpriseMemberInput
+
+// RemoveEnterpriseOrganizationInput is an alias of githubv4.RemoveEnterpriseOrganizationInput.
+type RemoveEnterpriseOrganizationInput = githubv4.RemoveEnterpriseOrganizationInput
+
+// RemoveEnterpriseSupportEntitlementInput is an alias of githubv4.RemoveEnterpriseSupportEntitlementInput.
+type RemoveEnterpriseSupportEntitlementInput = githubv4.RemoveEnterpriseSupportEntitlementInput
+
+// RemoveLabelsFromLabelableInput is an alias of githubv4.RemoveLabelsFromLabelableInput.
+type RemoveLabelsFromLabelableInput = githubv4.RemoveLabelsFromLabelableInput
+
+// RemoveOutsideCollaboratorInput is an alias of githubv4.RemoveOutsideCollaboratorInput.
+type RemoveOutsideCollaboratorInput = githubv4.RemoveOutsideCollaboratorInput
+
+// RemoveReactionInput is an alias of githubv4.RemoveReactionInput.
+type RemoveReactionInput = githubv4.RemoveReactionInput
+
+// RemoveStarInput is an alias of githubv4.RemoveStarInput.
+type RemoveStarInput = githubv4.RemoveStarInput
+
+// RemoveUpvoteInput is an alias of githubv4.RemoveUpvoteInput.
+type RemoveUpvoteInput = githubv4.RemoveUpvoteInput
+
+// ReopenDiscussionInput is an alias of githubv4.ReopenDiscussionInput.
+type ReopenDiscussionInput = githubv4.ReopenDiscussionInput
+
+// ReopenIssueInput is an alias of githubv4.ReopenIssueInput.
+type ReopenIssueInput = githubv4.ReopenIssueInput
+
+// ReopenPullRequestInput is an alias of githubv4.ReopenPullRequestInput.
+type ReopenPullRequestInput = githubv4.ReopenPullRequestInput
+
+// RepoAccessAuditEntryVisibility is an alias of githubv4.RepoAccessAuditEntryVisibility.
+type RepoAccessAuditEntryVisibility = githubv4.RepoAccessAuditEntryVisibility
+
+const (
+	RepoAccessAuditEntryVisibilityInternal = githubv4.RepoAccessAuditEntryVisibilityInternal
+	RepoAccessAuditEntryVisibilityPrivate  = githubv4.RepoAccessAuditEntryVisibilityPrivate
+	RepoAccessAuditEntryVisibilityPublic   = githubv4.RepoAccessAuditEntryVisibilityPublic
+)
+
+// RepoAddMemberAuditEntryVisibility is an alias of githubv4.RepoAddMemberAuditEntryVisibility.
+type RepoAddMemberAuditEntryVisibility = githubv4.RepoAddMemberAuditEntryVisibility
+
+const (
+	RepoAddMemberAuditEntryVisibilityInternal = githubv4.RepoAddMemberAuditEntryVisibilityInternal
+	RepoAddMemberAuditEntryVisibilityPrivate  = githubv4.RepoAddMemberAuditEntryVisibilityPrivate
+	RepoAddMemberAuditEntryVisibilityPublic   = githubv4.RepoAddMemberAuditEntryVisibilityPublic
+)
+
+// RepoArchivedAuditEntryVisibility is an alias of githubv4.RepoArchivedAuditEntryVisibility.
+type RepoArchivedAuditEntryVisibility = githubv4.RepoArchivedAuditEntryVisibility
+
+const (
+	RepoArchivedAuditEntryVisibilityInternal = githubv4.RepoArchivedAuditEntryVisibilityInternal
+	RepoArchivedAuditEntryVisibilityPrivate  = githubv4.RepoArchivedAuditEntryVisibilityPrivate
+	RepoArchivedAuditEntryVisibilityPublic   = githubv4.RepoArchivedAuditEntryVisibilityPublic
+)
+
+// RepoChangeMergeSettingAuditEntryMergeType is an alias of githubv4.RepoChangeMergeSettingAuditEntryMergeType.
+type RepoChangeMergeSettingAuditEntryMergeType = githubv4.RepoChangeMergeSettingAuditEntryMergeType
+
+const (
+	RepoChangeMergeSettingAuditEntryMergeTypeMerge  = githubv4.RepoChangeMergeSettingAuditEntryMergeTypeMerge
+	RepoChangeMergeSettingAuditEntryMergeTypeRebase = githubv4.RepoChangeMergeSettingAuditEntryMergeTypeRebase
+	RepoChangeMergeSettingAuditEntryMergeTypeSquash = githubv4.RepoChangeMergeSettingAuditEntryMergeTypeSquash
+)
+
+// RepoCreateAuditEntryVisibility is an alias of githubv4.RepoCreateAuditEntryVisibility.
+type RepoCreateAuditEntryVisibility = githubv4.RepoCreateAuditEntryVisibility
+
+const (
+	RepoCreateAuditEntryVisibilityInternal = githubv4.RepoCreateAuditEntryVisibilityInternal
+	RepoCreateAuditEntryVisibilityPrivate  = githubv4.RepoCreateAuditEntryVisibilityPrivate
+	RepoCreateAuditEntryVisibilityPublic   = githubv4.RepoCreateAuditEntryVisibilityPublic
+)
+
+// RepoDestroyAuditEntryVisibility is an alias of githubv4.RepoDestroyAuditEntryVisibility.
+type RepoDestroyAuditEntryVisibility = githubv4.RepoDestroyAuditEntryVisibility
+
+const (
+	RepoDestroyAuditEntryVisibilityInternal = githubv4.RepoDestroyAuditEntryVisibilityInternal
+	RepoDestroyAuditEntryVisibilityPrivate  = githubv4.RepoDestroyAuditEntryVisibilityPrivate
+	RepoDestroyAuditEntryVisibilityPublic   = githubv4.RepoDestroyAuditEntryVisibilityPublic
+)
+
+// RepoRemoveMemberAuditEntryVisibility is an alias of githubv4.RepoRemoveMemberAuditEntryVisibility.
+type RepoRemoveMemberAuditEntryVisibility = githubv4.RepoRemoveMemberAuditEntryVisibility
+
+const (
+	RepoRemoveMemberAuditEntryVisibilityInternal = githubv4.RepoRemoveMemberAuditEntryVisibilityInternal
+	RepoRemoveMemberAuditEntryVisibilityPrivate  = githubv4.RepoRemoveMemberAuditEntryVisibilityPrivate
+	RepoRemoveMemberAuditEntryVisibilityPublic   = githubv4.RepoRemoveMemberAuditEntryVisibilityPublic
+)
+
+// ReportedContentClassifiers is an alias of githubv4.ReportedContentClassifiers.
+type ReportedContentClassifiers = githubv4.ReportedContentClassifiers
+
+const (
+	ReportedContentClassifiersSpam      = githubv4.ReportedContentClassifiersSpam
+	ReportedContentClassifiersAbuse     = githubv4.ReportedContentClassifiersAbuse
+	ReportedContentClassifiersOffTopic  = githubv4.ReportedContentClassifiersOffTopic
+	ReportedContentClassifiersOutdated  = githubv4.ReportedContentClassifiersOutdated
+	ReportedContentClassifiersDuplicate = githubv4.ReportedContentClassifiersDuplicate
+	ReportedContentClassifiersResolved  = githubv4.ReportedContentClassifiersResolved
+)
+
+// RepositoryAffiliation is an alias of githubv4.RepositoryAffiliation.
+type RepositoryAffiliation = githubv4.RepositoryAffiliation
+
+const (
+	RepositoryAffiliationOwner              = githubv4.RepositoryAffiliationOwner
+	RepositoryAffiliationCollaborator       = githubv4.RepositoryAffiliationCollaborator
+	RepositoryAffiliationOrganizationMember = githubv4.RepositoryAffiliationOrganizationMember
+)
+
+// RepositoryContributionType is an alias of githubv4.RepositoryContributionType.
+type RepositoryContributionType = githubv4.RepositoryContributionType
+
+const (
+	RepositoryContributionTypeCommit            = githubv4.RepositoryContributionTypeCommit
+	RepositoryContributionTypeIssue             = githubv4.RepositoryContributionTypeIssue
+	RepositoryContributionTypePullRequest       = githubv4.RepositoryContributionTypePullRequest
+	RepositoryContributionTypeRepository        = githubv4.RepositoryContributionTypeRepository
+	RepositoryContributionTypePullRequestReview = githubv4.RepositoryContributionTypePullRequestReview
+)
+
+// RepositoryIDConditionTargetInput is an alias of githubv4.RepositoryIDConditionTargetInput.
+type RepositoryIDConditionTargetInput = githubv4.RepositoryIDConditionTargetInput
+
+// RepositoryInteractionLimit is an alias of githubv4.RepositoryInteractionLimit.
+type RepositoryInteractionLimit = githubv4.RepositoryInteractionLimit
+
+const (
+	RepositoryInteractionLimitExistingUsers     = githubv4.RepositoryInteractionLimitExistingUsers
+	RepositoryInteractionLimitContributorsOnly  = githubv4.RepositoryInteractionLimitContributorsOnly
+	RepositoryInteractionLimitCollaboratorsOnly = githubv4.RepositoryInteractionLimitCollaboratorsOnly
+	RepositoryInteractionLimitNoLimit           = githubv4.RepositoryInteractionLimitNoLimit
+)
+
+// RepositoryInteractionLimitExpiry is an alias of githubv4.RepositoryInteractionLimitExpiry.
+type RepositoryInteractionLimitExpiry = githubv4.RepositoryInteractionLimitExpiry
+
+const (
+	RepositoryInteractionLimitExpiryOneDay    = githubv4.RepositoryInteractionLimitExpiryOneDay
+	RepositoryInteractionLimitExpiryThreeDays = githubv4.RepositoryInteractionLimitExpiryThreeDays
+	RepositoryInteractionLimitExpiryOneWeek   = githubv4.RepositoryInteractionLimitExpiryOneWeek
+	RepositoryInteractionLimitExpiryOneMonth  = githubv4.RepositoryInteractionLimitExpiryOneMonth
+	RepositoryInteractionLimitExpirySixMonths = githubv4.RepositoryInteractionLimitExpirySixMonths
+)
+
+// RepositoryInteractionLimitOrigin is an alias of githubv4.RepositoryInteractionLimitOrigin.
+type RepositoryInteractionLimitOrigin = githubv4.RepositoryInteractionLimitOrigin
+
+const (
+	RepositoryInteractionLimitOriginRepository   = githubv4.RepositoryInteractionLimitOriginRepository
+	RepositoryInteractionLimitOriginOrganization = githubv4.RepositoryInteractionLimitOriginOrganization
+	RepositoryInteractionLimitOriginUser         = githubv4.RepositoryInteractionLimitOriginUser
+)
+
+// RepositoryInvitationOrder is an alias of githubv4.RepositoryInvitationOrder.
+type RepositoryInvitationOrder = githubv4.RepositoryInvitationOrder
+
+// RepositoryInvitationOrderField is an alias of githubv4.RepositoryInvitationOrderField.
+type RepositoryInvitationOrderField = githubv4.RepositoryInvitationOrderField
+
+const (
+	RepositoryInvitationOrderFieldCreatedAt = githubv4.RepositoryInvitationOrderFieldCreatedAt
+)
+
+// RepositoryLockReason is an alias of githubv4.RepositoryLockReason.
+type RepositoryLockReason = githubv4.RepositoryLockReason
+
+const (
+	RepositoryLockReasonMoving           = githubv4.RepositoryLockReasonMoving
+	RepositoryLockReasonBilling          = githubv4.RepositoryLockReasonBilling
+	RepositoryLockReasonRename           = githubv4.RepositoryLockReasonRename
+	RepositoryLockReasonMigrating        = githubv4.RepositoryLockReasonMigrating
+	RepositoryLockReasonTradeRestriction = githubv4.RepositoryLockReasonTradeRestriction
+)
+
+// RepositoryMigrationOrder is an alias of githubv4.RepositoryMigrationOrder.
+type RepositoryMigrationOrder = githubv4.RepositoryMigrationOrder
+
+// RepositoryMigrationOrderDirection is an alias of githubv4.RepositoryMigrationOrderDirection.
+type RepositoryMigrationOrderDirection = githubv4.RepositoryMigrationOrderDirection
+
+const (
+	RepositoryMigrationOrderDirectionAsc  = githubv4.RepositoryMigrationOrderDirectionAsc
+	RepositoryMigrationOrderDirectionDesc = githubv4.RepositoryMigrationOrderDirectionDesc
+)
+
+// RepositoryMigrationOrderField is an alias of githubv4.RepositoryMigrationOrderField.
+type RepositoryMigrationOrderField = githubv4.RepositoryMigrationOrderField
+
+const (
+	RepositoryMigrationOrderFieldCreatedAt = githubv4.RepositoryMigrationOrderFieldCreatedAt
+)
+
+// RepositoryNameConditionTargetInput is an alias of githubv4.RepositoryNameConditionTargetInput.
+type RepositoryNameConditionTargetInput = githubv4.RepositoryNameConditionTargetInput
+
+// RepositoryOrder is an alias of githubv4.RepositoryOrder.
+type RepositoryOrder = githubv4.RepositoryOrder
+
+// RepositoryOrderField is an alias of githubv4.RepositoryOrderField.
+type RepositoryOrderField = githubv4.RepositoryOrderField
+
+const (
+	RepositoryOrderFieldCreatedAt  = githubv4.RepositoryOrderFieldCreatedAt
+	RepositoryOrderFieldUpdatedAt  = githubv4.RepositoryOrderFieldUpdatedAt
+	RepositoryOrderFieldPushedAt   = githubv4.RepositoryOrderFieldPushedAt
+	RepositoryOrderFieldName       = githubv4.RepositoryOrderFieldName
+	RepositoryOrderFieldStargazers = githubv4.RepositoryOrderFieldStargazers
+)
+
+// RepositoryPermission is an alias of githubv4.RepositoryPermission.
+type RepositoryPermission = githubv4.RepositoryPermission
+
+const (
+	RepositoryPermissionAdmin    = githubv4.RepositoryPermissionAdmin
+	RepositoryPermissionMaintain = githubv4.RepositoryPermissionMaintain
+	RepositoryPermissionWrite    = githubv4.RepositoryPermissionWrite
+	RepositoryPermissionTriage   = githubv4.RepositoryPermissionTriage
+	RepositoryPermissionRead     = githubv4.RepositoryPermissionRead
+)
+
+// RepositoryPrivacy is an alias of githubv4.RepositoryPrivacy.
+type RepositoryPrivacy = githubv4.RepositoryPrivacy
+
+const (
+	RepositoryPrivacyPublic  = githubv4.RepositoryPrivacyPublic
+	RepositoryPrivacyPrivate = githubv4.RepositoryPrivacyPrivate
+)
+
+// RepositoryRuleConditionsInput is an alias of githubv4.RepositoryRuleConditionsInput.
+type RepositoryRuleConditionsInput = githubv4.RepositoryRuleConditionsInput
+
+// RepositoryRuleInput is an alias of githubv4.RepositoryRuleInput.
+type RepositoryRuleInput = githubv4.RepositoryRuleInput
+
+// RepositoryRuleType is an alias of githubv4.RepositoryRuleType.
+type RepositoryRuleType = githubv4.RepositoryRuleType
+
+const (
+	RepositoryRuleTypeCreation                 = githubv4.RepositoryRuleTypeCreation
+	RepositoryRuleTypeUpdate                   = githubv4.RepositoryRuleTypeUpdate
+	RepositoryRuleTypeDeletion                 = githubv4.RepositoryRuleTypeDeletion
+	RepositoryRuleTypeRequiredLinearHistory    = githubv4.RepositoryRuleTypeRequiredLinearHistory
+	RepositoryRuleTypeRequiredDeployments      = githubv4.RepositoryRuleTypeRequiredDeployments
+	RepositoryRuleTypeRequiredSignatures       = githubv4.RepositoryRuleTypeRequiredSignatures
+	RepositoryRuleTypePullRequest              = githubv4.RepositoryRuleTypePullRequest
+	RepositoryRuleTypeRequiredStatusChecks     = githubv4.RepositoryRuleTypeRequiredStatusChecks
+	RepositoryRuleTypeNonFastForward           = githubv4.RepositoryRuleTypeNonFastForward
+	RepositoryRuleTypeCommitMessagePattern     = githubv4.RepositoryRuleTypeCommitMessagePattern
+	RepositoryRuleTypeCommitAuthorEmailPattern = githubv4.RepositoryRuleTypeCommitAuthorEmailPattern
+	RepositoryRuleTypeCommitterEmailPattern    = githubv4.RepositoryRuleTypeCommitterEmailPattern
+	RepositoryRuleTypeBranchNamePattern        = githubv4.RepositoryRuleTypeBranchNamePattern
+	RepositoryRuleTypeTagNamePattern           = githubv4.RepositoryRuleTypeTagNamePattern
+)
+
+// RepositoryRulesetBypassActorBypassMode is an alias of githubv4.RepositoryRulesetBypassActorBypassMode.
+type RepositoryRulesetBypassActorBypassMode = githubv4.RepositoryRulesetBypassActorBypassMode
+
+const (
+	RepositoryRulesetBypassActorBypassModeAlways      = githubv4.RepositoryRulesetBypassActorBypassModeAlways
+	RepositoryRulesetBypassActorBypassModePullRequest = githubv4.RepositoryRulesetBypassActorBypassModePullRequest
+)
+
+// RepositoryRulesetBypassActorInput is an alias of githubv4.RepositoryRulesetBypassActorInput.
+type RepositoryRulesetBypassActorInput = githubv4.RepositoryRulesetBypassActorInput
+
+// RepositoryRulesetTarget is an alias of githubv4.RepositoryRulesetTarget.
+type RepositoryRulesetTarget = githubv4.RepositoryRulesetTarget
+
+const (
+	RepositoryRulesetTargetBranch = githubv4.RepositoryRulesetTargetBranch
+	RepositoryRulesetTargetTag    = githubv4.RepositoryRulesetTargetTag
+)
+
+// RepositoryVisibility is an alias of githubv4.RepositoryVisibility.
+type RepositoryVisibility = githubv4.RepositoryVisibility
+
+const (
+	RepositoryVisibilityPrivate  = githubv4.RepositoryVisibilityPrivate
+	RepositoryVisibilityPublic   = githubv4.RepositoryVisibilityPublic
+	RepositoryVisibilityInternal = githubv4.RepositoryVisibilityInternal
+)
+
+// RepositoryVulnerabilityAlertDependencyScope is an alias of githubv4.RepositoryVulnerabilityAlertDependencyScope.
+type RepositoryVulnerabilityAlertDependencyScope = githubv4.RepositoryVulnerabilityAlertDependencyScope
+
+const (
+	RepositoryVulnerabilityAlertDependencyScopeRuntime     = githubv4.RepositoryVulnerabilityAlertDependencyScopeRuntime
+	RepositoryVulnerabilityAlertDependencyScopeDevelopment = githubv4.RepositoryVulnerabilityAlertDependencyScopeDevelopment
+)
+
+// RepositoryVulnerabilityAlertState is an alias of githubv4.RepositoryVulnerabilityAlertState.
+type RepositoryVulnerabilityAlertState = githubv4.RepositoryVulnerabilityAlertState
+
+const (
+	RepositoryVulnerabilityAlertStateOpen          = githubv4.RepositoryVulnerabilityAlertStateOpen
+	RepositoryVulnerabilityAlertStateFixed         = githubv4.RepositoryVulnerabilityAlertStateFixed
+	RepositoryVulnerabilityAlertStateDismissed     = githubv4.RepositoryVulnerabilityAlertStateDismissed
+	RepositoryVulnerabilityAlertStateAutoDismissed = githubv4.RepositoryVulnerabilityAlertStateAutoDismissed
+)
+
+// RequestReviewsInput is an alias of githubv4.RequestReviewsInput.
+type RequestReviewsInput = githubv4.RequestReviewsInput
+
+// RequestableCheckStatusState is an alias of githubv4.RequestableCheckStatusState.
+type RequestableCheckStatusState = githubv4.RequestableCheckStatusState
+
+const (
+	RequestableCheckStatusStateQueued     = githubv4.RequestableCheckStatusStateQueued
+	RequestableCheckStatusStateInProgress = githubv4.RequestableCheckStatusStateInProgress
+	RequestableCheckStatusStateCompleted  = githubv4.RequestableCheckStatusStateCompleted
+	RequestableCheckStatusStateWaiting    = githubv4.RequestableCheckStatusStateWaiting
+	RequestableCheckStatusStatePending    = githubv4.RequestableCheckStatusStatePending
+)
+
+// RequiredDeploymentsParametersInput is an alias of githubv4.RequiredDeploymentsParametersInput.
+type RequiredDeploymentsParametersInput = githubv4.RequiredDeploymentsParametersInput
+
+// RequiredStatusCheckInput is an alias of githubv4.RequiredStatusCheckInput.
+type RequiredStatusCheckInput = githubv4.RequiredStatusCheckInput
+
+// RequiredStatusChecksParametersInput is an alias of githubv4.RequiredStatusChecksParametersInput.
+type RequiredStatusChecksParametersInput = githubv4.RequiredStatusChecksParametersInput
+
+// RerequestCheckSuiteInput is an alias of githubv4.RerequestCheckSuiteInput.
+type RerequestCheckSuiteInput = githubv4.RerequestCheckSuiteInput
+
+// ResolveReviewThreadInput is an alias of githubv4.ResolveReviewThreadInput.
+type ResolveReviewThreadInput = githubv4.ResolveReviewThreadInput
+
+// RetireSponsorsTierInput is an alias of githubv4.RetireSponsorsTierInput.
+type RetireSponsorsTierInput = githubv4.RetireSponsorsTierInput
+
+// RevertPullRequestInput is an alias of githubv4.RevertPullRequestInput.
+type RevertPullRequestInput = githubv4.RevertPullRequestInput
+
+// RevokeEnterpriseOrganizationsMigratorRoleInput is an alias of githubv4.RevokeEnterpriseOrganizationsMigratorRoleInput.
+type RevokeEnterpriseOrganizationsMigratorRoleInput = githubv4.RevokeEnterpriseOrganizationsMigratorRoleInput
+
+// RevokeMigratorRoleInput is an alias of githubv4.RevokeMigratorRoleInput.
+type RevokeMigratorRoleInput = githubv4.RevokeMigratorRoleInput
+
+// RoleInOrganization is an alias of githubv4.RoleInOrganization.
+type RoleInOrganization = githubv4.RoleInOrganization
+
+const (
+	RoleInOrganizationOwner        = githubv4.RoleInOrganizationOwner
+	RoleInOrganizationDirectMember = githubv4.RoleInOrganizationDirectMember
+	RoleInOrganizationUnaffiliated = githubv4.RoleInOrganizationUnaffiliated
+)
+
+// RuleEnforcement is an alias of githubv4.RuleEnforcement.
+type RuleEnforcement = githubv4.RuleEnforcement
+
+const (
+	RuleEnforcementDisabled = githubv4.RuleEnforcementDisabled
+	RuleEnforcementActive   = githubv4.RuleEnforcementActive
+	RuleEnforcementEvaluate = githubv4.RuleEnforcementEvaluate
+)
+
+// RuleParametersInput is an alias of githubv4.RuleParametersInput.
+type RuleParametersInput = githubv4.RuleParametersInput
+
+// SamlDigestAlgorithm is an alias of githubv4.SamlDigestAlgorithm.
+type SamlDigestAlgorithm = githubv4.SamlDigestAlgorithm
+
+const (
+	SamlDigestAlgorithmSha1   = githubv4.SamlDigestAlgorithmSha1
+	SamlDigestAlgorithmSha256 = githubv4.SamlDigestAlgorithmSha256
+	SamlDigestAlgorithmSha384 = githubv4.SamlDigestAlgorithmSha384
+	SamlDigestAlgorithmSha512 = githubv4.SamlDigestAlgorithmSha512
+)
+
+// SamlSignatureAlgorithm is an alias of githubv4.SamlSignatureAlgorithm.
+type SamlSignatureAlgorithm = githubv4.SamlSignatureAlgorithm
+
+const (
+	SamlSignatureAlgorithmRsaSha1   = githubv4.SamlSignatureAlgorithmRsaSha1
+	SamlSignatureAlgorithmRsaSha256 = githubv4.SamlSignatureAlgorithmRsaSha256
+	SamlSignatureAlgorithmRsaSha384 = githubv4.SamlSignatureAlgorithmRsaSha384
+	SamlSignatureAlgorithmRsaSha512 = githubv4.SamlSignatureAlgorithmRsaSha512
+)
+
+// SavedReplyOrder is an alias of githubv4.SavedReplyOrder.
+type SavedReplyOrder = githubv4.SavedReplyOrder
+
+// SavedReplyOrderField is an alias of githubv4.SavedReplyOrderField.
+type SavedReplyOrderField = githubv4.SavedReplyOrderField
+
+const (
+	SavedReplyOrderFieldUpdatedAt = githubv4.SavedReplyOrderFieldUpdatedAt
+)
+
+// SearchType is an alias of githubv4.SearchType.
+type SearchType = githubv4.SearchType
+
+const (
+	SearchTypeIssue      = githubv4.SearchTypeIssue
+	SearchTypeRepository = githubv4.SearchTypeRepository
+	SearchTypeUser       = githubv4.SearchTypeUser
+	SearchTypeDiscussion = githubv4.SearchTypeDiscussion
+)
+
+// SecurityAdvisoryClassification is an alias of githubv4.SecurityAdvisoryClassification.
+type SecurityAdvisoryClassification = githubv4.SecurityAdvisoryClassification
+
+const (
+	SecurityAdvisoryClassificationGeneral = githubv4.SecurityAdvisoryClassificationGeneral
+	SecurityAdvisoryClassificationMalware = githubv4.SecurityAdvisoryClassificationMalware
+)
+
+// SecurityAdvisoryEcosystem is an alias of githubv4.SecurityAdvisoryEcosystem.
+type SecurityAdvisoryEcosystem = githubv4.SecurityAdvisoryEcosystem
+
+const (
+	SecurityAdvisoryEcosystemComposer = githubv4.SecurityAdvisoryEcosystemComposer
+	SecurityAdvisoryEcosystemErlang   = githubv4.SecurityAdvisoryEcosystemErlang
+	SecurityAdvisoryEcosystemActions  = githubv4.SecurityAdvisoryEcosystemActions
+	SecurityAdvisoryEcosystemGo       = githubv4.SecurityAdvisoryEcosystemGo
+	SecurityAdvisoryEcosystemMaven    = githubv4.SecurityAdvisoryEcosystemMaven
+	SecurityAdvisoryEcosystemNpm      = githubv4.SecurityAdvisoryEcosystemNpm
+	SecurityAdvisoryEcosystemNuget    = githubv4.SecurityAdvisoryEcosystemNuget
+	SecurityAdvisoryEcosystemPip      = githubv4.SecurityAdvisoryEcosystemPip
+	SecurityAdvisoryEcosystemPub      = githubv4.SecurityAdvisoryEcosystemPub
+	SecurityAdvisoryEcosystemRubygems = githubv4.SecurityAdvisoryEcosystemRubygems
+	SecurityAdvisoryEcosystemRust     = githubv4.SecurityAdvisoryEcosystemRust
+	SecurityAdvisoryEcosystemSwift    = githubv4.SecurityAdvisoryEcosystemSwift
+)
+
+// SecurityAdvisoryIdentifierFilter is an alias of githubv4.SecurityAdvisoryIdentifierFilter.
+type SecurityAdvisoryIdentifierFilter = githubv4.SecurityAdvisoryIdentifierFilter
+
+// SecurityAdvisoryIdentifierType is an alias of githubv4.SecurityAdvisoryIdentifierType.
+type SecurityAdvisoryIdentifierType = githubv4.SecurityAdvisoryIdentifierType
+
+const (
+	SecurityAdvisoryIdentifierTypeCve  = githubv4.SecurityAdvisoryIdentifierTypeCve
+	SecurityAdvisoryIdentifierTypeGhsa = githubv4.SecurityAdvisoryIdentifierTypeGhsa
+)
+
+// SecurityAdvisoryOrder is an alias of githubv4.SecurityAdvisoryOrder.
+type SecurityAdvisoryOrder = githubv4.SecurityAdvisoryOrder
+
+// SecurityAdvisoryOrderField is an alias of githubv4.SecurityAdvisoryOrderField.
+type SecurityAdvisoryOrderField = githubv4.SecurityAdvisoryOrderField
+
+const (
+	SecurityAdvisoryOrderFieldPublishedAt = githubv4.SecurityAdvisoryOrderFieldPublishedAt
+	SecurityAdvisoryOrderFieldUpdatedAt   = githubv4.SecurityAdvisoryOrderFieldUpdatedAt
+)
+
+// SecurityAdvisorySeverity is an alias of githubv4.SecurityAdvisorySeverity.
+type SecurityAdvisorySeverity = githubv4.SecurityAdvisorySeverity
+
+const (
+	SecurityAdvisorySeverityLow      = githubv4.SecurityAdvisorySeverityLow
+	SecurityAdvisorySeverityModerate = githubv4.SecurityAdvisorySeverityModerate
+	SecurityAdvisorySeverityHigh     = githubv4.SecurityAdvisorySeverityHigh
+	SecurityAdvisorySeverityCritical = githubv4.SecurityAdvisorySeverityCritical
+)
+
+// SecurityVulnerabilityOrder is an alias of githubv4.SecurityVulnerabilityOrder.
+type SecurityVulnerabilityOrder = githubv4.SecurityVulnerabilityOrder
+
+// SecurityVulnerabilityOrderField is an alias of githubv4.SecurityVulnerabilityOrderField.
+type SecurityVulnerabilityOrderField = githubv4.SecurityVulnerabilityOrderField
+
+const (
+	SecurityVulnerabilityOrderFieldUpdatedAt = githubv4.SecurityVulnerabilityOrderFieldUpdatedAt
+)
+
+// SetEnterpriseIdentityProviderInput is an alias of githubv4.SetEnterpriseIdentityProviderInput.
+type SetEnterpriseIdentityProviderInput = githubv4.SetEnterpriseIdentityProviderInput
+
+// SetOrganizationInteractionLimitInput is an alias of githubv4.SetOrganizationInteractionLimitInput.
+type SetOrganizationInteractionLimitInput = githubv4.SetOrganizationInteractionLimitInput
+
+// SetRepositoryInteractionLimitInput is an alias of githubv4.SetRepositoryInteractionLimitInput.
+type SetRepositoryInteractionLimitInput = githubv4.SetRepositoryInteractionLimitInput
+
+// SetUserInteractionLimitInput is an alias of githubv4.SetUserInteractionLimitInput.
+type SetUserInteractionLimitInput = githubv4.SetUserInteractionLimitInput
+
+// SocialAccountProvider is an alias of githubv4.SocialAccountProvider.
+type SocialAccountProvider = githubv4.SocialAccountProvider
+
+const (
+	SocialAccountProviderGeneric   = githubv4.SocialAccountProviderGeneric
+	SocialAccountProviderFacebook  = githubv4.SocialAccountProviderFacebook
+	SocialAccountProviderHometown  = githubv4.SocialAccountProviderHometown
+	SocialAccountProviderInstagram = githubv4.SocialAccountProviderInstagram
+	SocialAccountProviderLinkedin  = githubv4.SocialAccountProviderLinkedin
+	SocialAccountProviderMastodon  = githubv4.SocialAccountProviderMastodon
+	SocialAccountProviderReddit    = githubv4.SocialAccountProviderReddit
+	SocialAccountProviderTwitch    = githubv4.SocialAccountProviderTwitch
+	SocialAccountProviderTwitter   = githubv4.SocialAccountProviderTwitter
+	SocialAccountProviderYoutube   = githubv4.SocialAccountProviderYoutube
+)
+
+// SponsorOrder is an alias of githubv4.SponsorOrder.
+type SponsorOrder = githubv4.SponsorOrder
+
+// SponsorOrderField is an alias of githubv4.SponsorOrderField.
+type SponsorOrderField = githubv4.SponsorOrderField
+
+const (
+	SponsorOrderFieldLogin     = githubv4.SponsorOrderFieldLogin
+	SponsorOrderFieldRelevance = githubv4.SponsorOrderFieldRelevance
+)
+
+// SponsorableOrder is an alias of githubv4.SponsorableOrder.
+type SponsorableOrder = githubv4.SponsorableOrder
+
+// SponsorableOrderField is an alias of githubv4.SponsorableOrderField.
+type SponsorableOrderField = githubv4.SponsorableOrderField
+
+const (
+	SponsorableOrderFieldLogin = githubv4.SponsorableOrderFieldLogin
+)
+
+// SponsorsActivityAction is an alias of githubv4.SponsorsActivityAction.
+type SponsorsActivityAction = githubv4.SponsorsActivityAction
+
+const (
+	SponsorsActivityActionNewSponsorship       = githubv4.SponsorsActivityActionNewSponsorship
+	SponsorsActivityActionCancelledSponsorship = githubv4.SponsorsActivityActionCancelledSponsorship
+	SponsorsActivityActionTierChange           = githubv4.SponsorsActivityActionTierChange
+	SponsorsActivityActionRefund               = githubv4.SponsorsActivityActionRefund
+	SponsorsActivityActionPendingChange        = githubv4.SponsorsActivityActionPendingChange
+	SponsorsActivityActionSponsorMatchDisabled = githubv4.SponsorsActivityActionSponsorMatchDisabled
+)
+
+// SponsorsActivityOrder is an alias of githubv4.SponsorsActivityOrder.
+type SponsorsActivityOrder = githubv4.SponsorsActivityOrder
+
+// SponsorsActivityOrderField is an alias of githubv4.SponsorsActivityOrderField.
+type SponsorsActivityOrderField = githubv4.SponsorsActivityOrderField
+
+const (
+	SponsorsActivityOrderFieldTimestamp = githubv4.SponsorsActivityOrderFieldTimestamp
+)
+
+// SponsorsActivityPeriod is an alias of githubv4.SponsorsActivityPeriod.
+type SponsorsActivityPeriod = githubv4.SponsorsActivityPeriod
+
+const (
+	SponsorsActivityPeriodDay   = githubv4.SponsorsActivityPeriodDay
+	SponsorsActivityPeriodWeek  = githubv4.SponsorsActivityPeriodWeek
+	SponsorsActivityPeriodMonth = githubv4.SponsorsActivityPeriodMonth
+	SponsorsActivityPeriodAll   = githubv4.SponsorsActivityPeriodAll
+)
+
+// SponsorsCountryOrRegionCode is an alias of githubv4.SponsorsCountryOrRegionCode.
+type SponsorsCountryOrRegionCode = githubv4.SponsorsCountryOrRegionCode
+
+const (
+	SponsorsCountryOrRegionCodeAf = githubv4.SponsorsCountryOrRegionCodeAf
+	SponsorsCountryOrRegionCodeAx = githubv4.SponsorsCountryOrRegionCodeAx
+	SponsorsCountryOrRegionCodeAl = githubv4.SponsorsCountryOrRegionCodeAl
+	SponsorsCountryOrRegionCodeDz = githubv4.SponsorsCountryOrRegionCodeDz
+	SponsorsCountryOrRegionCodeAs = githubv4.SponsorsCountryOrRegionCodeAs
+	SponsorsCountryOrRegionCodeAd = githubv4.SponsorsCountryOrRegionCodeAd
+	SponsorsCountryOrRegionCodeAo = githubv4.SponsorsCountryOrRegionCodeAo
+	SponsorsCountryOrRegionCodeAi = githubv4.SponsorsCountryOrRegionCodeAi
+	SponsorsCountryOrRegionCodeAq = githubv4.SponsorsCountryOrRegionCodeAq
+	SponsorsCountryOrRegionCodeAg = githubv4.SponsorsCountryOrRegionCodeAg
+	SponsorsCountryOrRegionCodeAr = githubv4.SponsorsCountryOrRegionCodeAr
+	SponsorsCountryOrRegionCodeAm = githubv4.SponsorsCountryOrRegionCodeAm
+	SponsorsCountryOrRegionCodeAw = githubv4.SponsorsCountryOrRegionCodeAw
+	SponsorsCountryOrRegionCodeAu = githubv4.SponsorsCountryOrRegionCodeAu
+	SponsorsCountryOrRegionCodeAt = githubv4.SponsorsCountryOrRegionCodeAt
+	SponsorsCountryOrRegionCodeAz = githubv4.SponsorsCountryOrRegionCodeAz
+	SponsorsCountryOrRegionCodeBs = githubv4.SponsorsCountryOrRegionCodeBs
+	SponsorsCountryOrRegionCodeBh = githubv4.SponsorsCountryOrRegionCodeBh
+	SponsorsCountryOrRegionCodeBd = githubv4.SponsorsCountryOrRegionCodeBd
+	SponsorsCountryOrRegionCodeBb = githubv4.SponsorsCountryOrRegionCodeBb
+	SponsorsCountryOrRegionCodeBy = githubv4.SponsorsCountryOrRegionCodeBy
+	SponsorsCountryOrRegionCodeBe = githubv4.SponsorsCountryOrRegionCodeBe
+	SponsorsCountryOrRegionCodeBz = githubv4.SponsorsCountryOrRegionCodeBz
+	SponsorsCountryOrRegionCodeBj = githubv4.SponsorsCountryOrRegionCodeBj
+	SponsorsCountryOrRegionCodeBm = githubv4.SponsorsCountryOrRegionCodeBm
+	SponsorsCountryOrRegionCodeBt = githubv4.SponsorsCountryOrRegionCodeBt
+	SponsorsCountryOrRegionCodeBo = githubv4.SponsorsCountryOrRegionCodeBo
+	SponsorsCountryOrRegionCodeBq = githubv4.SponsorsCountryOrRegionCodeBq
+	SponsorsCountryOrRegionCodeBa = githubv4.SponsorsCountryOrRegionCodeBa
+	SponsorsCountryOrRegionCodeBw = githubv4.SponsorsCountryOrRegionCodeBw
+	SponsorsCountryOrRegionCodeBv = githubv4.SponsorsCountryOrRegionCodeBv
+	SponsorsCountryOrRegionCodeBr = githubv4.SponsorsCountryOrRegionCodeBr
+	SponsorsCountryOrRegionCodeIo = githubv4.SponsorsCountryOrRegionCodeIo
+	SponsorsCountryOrRegionCodeBn = githubv4.SponsorsCountryOrRegionCodeBn
+	SponsorsCountryOrRegionCodeBg = githubv4.SponsorsCountryOrRegionCodeBg
+	SponsorsCountryOrRegionCodeBf = githubv4.SponsorsCountryOrRegionCodeBf
+	SponsorsCountryOrRegionCodeBi = githubv4.SponsorsCountryOrRegionCodeBi
+	SponsorsCountryOrRegionCodeKh = githubv4.SponsorsCountryOrRegionCodeKh
+	SponsorsCountryOrRegionCodeCm = githubv4.SponsorsCountryOrRegionCodeCm
+	SponsorsCountryOrRegionCodeCa = githubv4.SponsorsCountryOrRegionCodeCa
+	SponsorsCountryOrRegionCodeCv = githubv4.SponsorsCountryOrRegionCodeCv
+	SponsorsCountryOrRegionCodeKy = githubv4.SponsorsCountryOrRegionCodeKy
+	SponsorsCountryOrRegionCodeCf = githubv4.SponsorsCountryOrRegionCodeCf
+	SponsorsCountryOrRegionCodeTd = githubv4.SponsorsCountryOrRegionCodeTd
+	SponsorsCountryOrRegionCodeCl = githubv4.SponsorsCountryOrRegionCodeCl
+	SponsorsCountryOrRegionCodeCn = githubv4.SponsorsCountryOrRegionCodeCn
+	SponsorsCountryOrRegionCodeCx = githubv4.SponsorsCountryOrRegionCodeCx
+	SponsorsCountryOrRegionCodeCc = githubv4.SponsorsCountryOrRegionCodeCc
+	SponsorsCountryOrRegionCodeCo = githubv4.SponsorsCountryOrRegionCodeCo
+	SponsorsCountryOrRegionCodeKm = githubv4.SponsorsCountryOrRegionCodeKm
+	SponsorsCountryOrRegionCodeCg = githubv4.SponsorsCountryOrRegionCodeCg
+	SponsorsCountryOrRegionCodeCd = githubv4.SponsorsCountryOrRegionCodeCd
+	SponsorsCountryOrRegionCodeCk = githubv4.SponsorsCountryOrRegionCodeCk
+	SponsorsCountryOrRegionCodeCr = githubv4.SponsorsCountryOrRegionCodeCr
+	SponsorsCountryOrRegionCodeCi = githubv4.SponsorsCountryOrRegionCodeCi
+	SponsorsCountryOrRegionCodeHr = githubv4.SponsorsCountryOrRegionCodeHr
+	SponsorsCountryOrRegionCodeCw = githubv4.SponsorsCountryOrRegionCodeCw
+	SponsorsCountryOrRegionCodeCy = githubv4.SponsorsCountryOrRegionCodeCy
+	SponsorsCountryOrRegionCodeCz = githubv4.SponsorsCountryOrRegionCodeCz
+	SponsorsCountryOrRegionCodeDk = githubv4.SponsorsCountryOrRegionCodeDk
+	SponsorsCountryOrRegionCodeDj = githubv4.SponsorsCountryOrRegionCodeDj
+	SponsorsCountryOrRegionCodeDm = githubv4.SponsorsCountryOrRegionCodeDm
+	SponsorsCountryOrRegionCodeDo = githubv4.SponsorsCountryOrRegionCodeDo
+	SponsorsCountryOrRegionCodeEc = githubv4.SponsorsCountryOrRegionCodeEc
+	SponsorsCountryOrRegionCodeEg = githubv4.SponsorsCountryOrRegionCodeEg
+	SponsorsCountryOrRegionCodeSv = githubv4.SponsorsCountryOrRegionCodeSv
+	SponsorsCountryOrRegionCodeGq = githubv4.SponsorsCountryOrRegionCodeGq
+	SponsorsCountryOrRegionCodeEr = githubv4.SponsorsCountryOrRegionCodeEr
+	SponsorsCountryOrRegionCodeEe = githubv4.SponsorsCountryOrRegionCodeEe
+	SponsorsCountryOrRegionCodeEt = githubv4.SponsorsCountryOrRegionCodeEt
+	SponsorsCountryOrRegionCodeFk = githubv4.SponsorsCountryOrRegionCodeFk
+	SponsorsCountryOrRegionCodeFo = githubv4.SponsorsCountryOrRegionCodeFo
+	SponsorsCountryOrRegionCodeFj = githubv4.SponsorsCountryOrRegionCodeFj
+	SponsorsCountryOrRegionCodeFi = githubv4.SponsorsCountryOrRegionCodeFi
+	SponsorsCountryOrRegionCodeFr = githubv4.SponsorsCountryOrRegionCodeFr
+	SponsorsCountryOrRegionCodeGf = githubv4.SponsorsCountryOrRegionCodeGf
+	SponsorsCountryOrRegionCodePf = githubv4.SponsorsCountryOrRegionCodePf
+	SponsorsCountryOrRegionCodeTf = githubv4.SponsorsCountryOrRegionCodeTf
+	SponsorsCountryOrRegionCodeGa = githubv4.SponsorsCountryOrRegionCodeGa
+	SponsorsCountryOrRegionCodeGm = githubv4.SponsorsCountryOrRegionCodeGm
+	SponsorsCountryOrRegionCodeGe = githubv4.SponsorsCountryOrRegionCodeGe
+	SponsorsCountryOrRegionCodeDe = githubv4.SponsorsCountryOrRegionCodeDe
+	SponsorsCountryOrRegionCodeGh = githubv4.SponsorsCountryOrRegionCodeGh
+	SponsorsCountryOrRegionCodeGi = githubv4.SponsorsCountryOrRegionCodeGi
+	SponsorsCountryOrRegionCodeGr = githubv4.SponsorsCountryOrRegionCodeGr
+	SponsorsCountryOrRegionCodeGl = githubv4.SponsorsCountryOrRegionCodeGl
+	SponsorsCountryOrRegionCodeGd = githubv4.SponsorsCountryOrRegionCodeGd
+	SponsorsCountryOrRegionCodeGp = githubv4.SponsorsCountryOrRegionCodeGp
+	SponsorsCountryOrRegionCodeGu = githubv4.SponsorsCountryOrRegionCodeGu
+	SponsorsCountryOrRegionCodeGt = githubv4.SponsorsCountryOrRegionCodeGt
+	SponsorsCountryOrRegionCodeGg = githubv4.SponsorsCountryOrRegionCodeGg
+	SponsorsCountryOrRegionCodeGn = githubv4.SponsorsCountryOrRegionCodeGn
+	SponsorsCountryOrRegionCodeGw = githubv4.SponsorsCountryOrRegionCodeGw
+	SponsorsCountryOrRegionCodeGy = githubv4.SponsorsCountryOrRegionCodeGy
+	SponsorsCountryOrRegionCodeHt = githubv4.SponsorsCountryOrRegionCodeHt
+	SponsorsCountryOrRegionCodeHm = githubv4.SponsorsCountryOrRegionCodeHm
+	SponsorsCountryOrRegionCodeHn = githubv4.SponsorsCountryOrRegionCodeHn
+	SponsorsCountryOrRegionCodeHk = githubv4.SponsorsCountryOrRegionCodeHk
+	SponsorsCountryOrRegionCodeHu = githubv4.SponsorsCountryOrRegionCodeHu
+	SponsorsCountryOrRegionCodeIs = githubv4.SponsorsCountryOrRegionCodeIs
+	SponsorsCountryOrRegionCodeIn = githubv4.SponsorsCountryOrRegionCodeIn
+	SponsorsCountryOrRegionCodeID = githubv4.SponsorsCountryOrRegionCodeID
+	SponsorsCountryOrRegionCodeIr = githubv4.SponsorsCountryOrRegionCodeIr
+	SponsorsCountryOrRegionCodeIq = githubv4.SponsorsCountryOrRegionCodeIq
+	SponsorsCountryOrRegionCodeIe = githubv4.SponsorsCountryOrRegionCodeIe
+	SponsorsCountryOrRegionCodeIm = githubv4.SponsorsCountryOrRegionCodeIm
+	SponsorsCountryOrRegionCodeIl = githubv4.SponsorsCountryOrRegionCodeIl
+	SponsorsCountryOrRegionCodeIt = githubv4.SponsorsCountryOrRegionCodeIt
+	SponsorsCountryOrRegionCodeJm = githubv4.SponsorsCountryOrRegionCodeJm
+	SponsorsCountryOrRegionCodeJp = githubv4.SponsorsCountryOrRegionCodeJp
+	SponsorsCountryOrRegionCodeJe = githubv4.SponsorsCountryOrRegionCodeJe
+	SponsorsCountryOrRegionCodeJo = githubv4.SponsorsCountryOrRegionCodeJo
+	SponsorsCountryOrRegionCodeKz = githubv4.SponsorsCountryOrRegionCodeKz
+	SponsorsCountryOrRegionCodeKe = githubv4.SponsorsCountryOrRegionCodeKe
+	SponsorsCountryOrRegionCodeKi = githubv4.SponsorsCountryOrRegionCodeKi
+	SponsorsCountryOrRegionCodeKr = githubv4.SponsorsCountryOrRegionCodeKr
+	SponsorsCountryOrRegionCodeKw = githubv4.SponsorsCountryOrRegionCodeKw
+	SponsorsCountryOrRegionCodeKg = githubv4.SponsorsCountryOrRegionCodeKg
+	SponsorsCountryOrRegionCodeLa = githubv4.SponsorsCountryOrRegionCodeLa
+	SponsorsCountryOrRegionCodeLv = githubv4.SponsorsCountryOrRegionCodeLv
+	SponsorsCountryOrRegionCodeLb = githubv4.SponsorsCountryOrRegionCodeLb
+	SponsorsCountryOrRegionCodeLs = githubv4.SponsorsCountryOrRegionCodeLs
+	SponsorsCountryOrRegionCodeLr = githubv4.SponsorsCountryOrRegionCodeLr
+	SponsorsCountryOrRegionCodeLy = githubv4.SponsorsCountryOrRegionCodeLy
+	SponsorsCountryOrRegionCodeLi = githubv4.SponsorsCountryOrRegionCodeLi
+	SponsorsCountryOrRegionCodeLt = githubv4.SponsorsCountryOrRegionCodeLt
+	SponsorsCountryOrRegionCodeLu = githubv4.SponsorsCountryOrRegionCodeLu
+	SponsorsCountryOrRegionCodeMo = githubv4.SponsorsCountryOrRegionCodeMo
+	SponsorsCountryOrRegionCodeMk = githubv4.SponsorsCountryOrRegionCodeMk
+	SponsorsCountryOrRegionCodeMg = githubv4.SponsorsCountryOrRegionCodeMg
+	SponsorsCountryOrRegionCodeMw = githubv4.SponsorsCountryOrRegionCodeMw
+	SponsorsCountryOrRegionCodeMy = githubv4.SponsorsCountryOrRegionCodeMy
+	SponsorsCountryOrRegionCodeMv = githubv4.SponsorsCountryOrRegionCodeMv
+	SponsorsCountryOrRegionCodeMl = githubv4.SponsorsCountryOrRegionCodeMl
+	SponsorsCountryOrRegionCodeMt = githubv4.SponsorsCountryOrRegionCodeMt
+	SponsorsCountryOrRegionCodeMh = githubv4.SponsorsCountryOrRegionCodeMh
+	SponsorsCountryOrRegionCodeMq = githubv4.SponsorsCountryOrRegionCodeMq
+	SponsorsCountryOrRegionCodeMr = githubv4.SponsorsCountryOrRegionCodeMr
+	SponsorsCountryOrRegionCodeMu = githubv4.SponsorsCountryOrRegionCodeMu
+	SponsorsCountryOrRegionCodeYt = githubv4.SponsorsCountryOrRegionCodeYt
+	SponsorsCountryOrRegionCodeMx = githubv4.SponsorsCountryOrRegionCodeMx
+	SponsorsCountryOrRegionCodeFm = githubv4.SponsorsCountryOrRegionCodeFm
+	SponsorsCountryOrRegionCodeMd = githubv4.SponsorsCountryOrRegionCodeMd
+	SponsorsCountryOrRegionCodeMc = githubv4.SponsorsCountryOrRegionCodeMc
+	SponsorsCountryOrRegionCodeMn = githubv4.SponsorsCountryOrRegionCodeMn
+	SponsorsCountryOrRegionCodeMe = githubv4.SponsorsCountryOrRegionCodeMe
+	SponsorsCountryOrRegionCodeMs = githubv4.SponsorsCountryOrRegionCodeMs
+	SponsorsCountryOrRegionCodeMa = githubv4.SponsorsCountryOrRegionCodeMa
+	SponsorsCountryOrRegionCodeMz = githubv4.SponsorsCountryOrRegionCodeMz
+	SponsorsCountryOrRegionCodeMm = githubv4.SponsorsCountryOrRegionCodeMm
+	SponsorsCountryOrRegionCodeNa = githubv4.SponsorsCountryOrRegionCodeNa
+	SponsorsCountryOrRegionCodeNr = githubv4.SponsorsCountryOrRegionCodeNr
+	SponsorsCountryOrRegionCodeNp = githubv4.SponsorsCountryOrRegionCodeNp
+	SponsorsCountryOrRegionCodeNl = githubv4.SponsorsCountryOrRegionCodeNl
+	SponsorsCountryOrRegionCodeNc = githubv4.SponsorsCountryOrRegionCodeNc
+	SponsorsCountryOrRegionCodeNz = githubv4.SponsorsCountryOrRegionCodeNz
+	SponsorsCountryOrRegionCodeNi = githubv4.SponsorsCountryOrRegionCodeNi
+	SponsorsCountryOrRegionCodeNe = githubv4.SponsorsCountryOrRegionCodeNe
+	SponsorsCountryOrRegionCodeNg = githubv4.SponsorsCountryOrRegionCodeNg
+	SponsorsCountryOrRegionCodeNu = githubv4.SponsorsCountryOrRegionCodeNu
+	SponsorsCountryOrRegionCodeNf = githubv4.SponsorsCountryOrRegionCodeNf
+	SponsorsCountryOrRegionCodeMp = githubv4.SponsorsCountryOrRegionCodeMp
+	SponsorsCountryOrRegionCodeNo = githubv4.SponsorsCountryOrRegionCodeNo
+	SponsorsCountryOrRegionCodeOm = githubv4.SponsorsCountryOrRegionCodeOm
+	SponsorsCountryOrRegionCodePk = githubv4.SponsorsCountryOrRegionCodePk
+	SponsorsCountryOrRegionCodePw = githubv4.SponsorsCountryOrRegionCodePw
+	SponsorsCountryOrRegionCodePs = githubv4.SponsorsCountryOrRegionCodePs
+	SponsorsCountryOrRegionCodePa = githubv4.SponsorsCountryOrRegionCodePa
+	SponsorsCountryOrRegionCodePg = githubv4.SponsorsCountryOrRegionCodePg
+	SponsorsCountryOrRegionCodePy = githubv4.SponsorsCountryOrRegionCodePy
+	SponsorsCountryOrRegionCodePe = githubv4.SponsorsCountryOrRegionCodePe
+	SponsorsCountryOrRegionCodePh = githubv4.SponsorsCountryOrRegionCodePh
+	SponsorsCountryOrRegionCodePn = githubv4.SponsorsCountryOrRegionCodePn
+	SponsorsCountryOrRegionCodePl = githubv4.SponsorsCountryOrRegionCodePl
+	SponsorsCountryOrRegionCodePt = githubv4.SponsorsCountryOrRegionCodePt
+	SponsorsCountryOrRegionCodePr = githubv4.SponsorsCountryOrRegionCodePr
+	SponsorsCountryOrRegionCodeQa = githubv4.SponsorsCountryOrRegionCodeQa
+	SponsorsCountryOrRegionCodeRe = githubv4.SponsorsCountryOrRegionCodeRe
+	SponsorsCountryOrRegionCodeRo = githubv4.SponsorsCountryOrRegionCodeRo
+	SponsorsCountryOrRegionCodeRu = githubv4.SponsorsCountryOrRegionCodeRu
+	SponsorsCountryOrRegionCodeRw = githubv4.SponsorsCountryOrRegionCodeRw
+	SponsorsCountryOrRegionCodeBl = githubv4.SponsorsCountryOrRegionCodeBl
+	SponsorsCountryOrRegionCodeSh = githubv4.SponsorsCountryOrRegionCodeSh
+	SponsorsCountryOrRegionCodeKn = githubv4.SponsorsCountryOrRegionCodeKn
+	SponsorsCountryOrRegionCodeLc = githubv4.SponsorsCountryOrRegionCodeLc
+	SponsorsCountryOrRegionCodeMf = githubv4.SponsorsCountryOrRegionCodeMf
+	SponsorsCountryOrRegionCodePm = githubv4.SponsorsCountryOrRegionCodePm
+	SponsorsCountryOrRegionCodeVc = githubv4.SponsorsCountryOrRegionCodeVc
+	SponsorsCountryOrRegionCodeWs = githubv4.SponsorsCountryOrRegionCodeWs
+	SponsorsCountryOrRegionCodeSm = githubv4.SponsorsCountryOrRegionCodeSm
+	SponsorsCountryOrRegionCodeSt = githubv4.SponsorsCountryOrRegionCodeSt
+	SponsorsCountryOrRegionCodeSa = githubv4.SponsorsCountryOrRegionCodeSa
+	SponsorsCountryOrRegionCodeSn = githubv4.SponsorsCountryOrRegionCodeSn
+	SponsorsCountryOrRegionCodeRs = githubv4.SponsorsCountryOrRegionCodeRs
+	SponsorsCountryOrRegionCodeSc = githubv4.SponsorsCountryOrRegionCodeSc
+	SponsorsCountryOrRegionCodeSl = githubv4.SponsorsCountryOrRegionCodeSl
+	SponsorsCountryOrRegionCodeSg = githubv4.SponsorsCountryOrRegionCodeSg
+	SponsorsCountryOrRegionCodeSx = githubv4.SponsorsCountryOrRegionCodeSx
+	SponsorsCountryOrRegionCodeSk = githubv4.SponsorsCountryOrRegionCodeSk
+	SponsorsCountryOrRegionCodeSi = githubv4.SponsorsCountryOrRegionCodeSi
+	SponsorsCountryOrRegionCodeSb = githubv4.SponsorsCountryOrRegionCodeSb
+	SponsorsCountryOrRegionCodeSo = githubv4.SponsorsCountryOrRegionCodeSo
+	SponsorsCountryOrRegionCodeZa = githubv4.SponsorsCountryOrRegionCodeZa
+	SponsorsCountryOrRegionCodeGs = githubv4.SponsorsCountryOrRegionCodeGs
+	SponsorsCountryOrRegionCodeSs = githubv4.SponsorsCountryOrRegionCodeSs
+	SponsorsCountryOrRegionCodeEs = githubv4.SponsorsCountryOrRegionCodeEs
+	SponsorsCountryOrRegionCodeLk = githubv4.SponsorsCountryOrRegionCodeLk
+	SponsorsCountryOrRegionCodeSd = githubv4.SponsorsCountryOrRegionCodeSd
+	SponsorsCountryOrRegionCodeSr = githubv4.SponsorsCountryOrRegionCodeSr
+	SponsorsCountryOrRegionCodeSj = githubv4.SponsorsCountryOrRegionCodeSj
+	SponsorsCountryOrRegionCodeSz = githubv4.SponsorsCountryOrRegionCodeSz
+	SponsorsCountryOrRegionCodeSe = githubv4.SponsorsCountryOrRegionCodeSe
+	SponsorsCountryOrRegionCodeCh = githubv4.SponsorsCountryOrRegionCodeCh
+	SponsorsCountryOrRegionCodeTw = githubv4.SponsorsCountryOrRegionCodeTw
+	SponsorsCountryOrRegionCodeTj = githubv4.SponsorsCountryOrRegionCodeTj
+	SponsorsCountryOrRegionCodeTz = githubv4.SponsorsCountryOrRegionCodeTz
+	SponsorsCountryOrRegionCodeTh = githubv4.SponsorsCountryOrRegionCodeTh
+	SponsorsCountryOrRegionCodeTl = githubv4.SponsorsCountryOrRegionCodeTl
+	SponsorsCountryOrRegionCodeTg = githubv4.SponsorsCountryOrRegionCodeTg
+	SponsorsCountryOrRegionCodeTk = githubv4.SponsorsCountryOrRegionCodeTk
+	SponsorsCountryOrRegionCodeTo = githubv4.SponsorsCountryOrRegionCodeTo
+	SponsorsCountryOrRegionCodeTt = githubv4.SponsorsCountryOrRegionCodeTt
+	SponsorsCountryOrRegionCodeTn = githubv4.SponsorsCountryOrRegionCodeTn
+	SponsorsCountryOrRegionCodeTr = githubv4.SponsorsCountryOrRegionCodeTr
+	SponsorsCountryOrRegionCodeTm = githubv4.SponsorsCountryOrRegionCodeTm
+	SponsorsCountryOrRegionCodeTc = githubv4.SponsorsCountryOrRegionCodeTc
+	SponsorsCountryOrRegionCodeTv = githubv4.SponsorsCountryOrRegionCodeTv
+	SponsorsCountryOrRegionCodeUg = githubv4.SponsorsCountryOrRegionCodeUg
+	SponsorsCountryOrRegionCodeUa = githubv4.SponsorsCountryOrRegionCodeUa
+	SponsorsCountryOrRegionCodeAe = githubv4.SponsorsCountryOrRegionCodeAe
+	SponsorsCountryOrRegionCodeGb = githubv4.SponsorsCountryOrRegionCodeGb
+	SponsorsCountryOrRegionCodeUm = githubv4.SponsorsCountryOrRegionCodeUm
+	SponsorsCountryOrRegionCodeUs = githubv4.SponsorsCountryOrRegionCodeUs
+	SponsorsCountryOrRegionCodeUy = githubv4.SponsorsCountryOrRegionCodeUy
+	SponsorsCountryOrRegionCodeUz = githubv4.SponsorsCountryOrRegionCodeUz
+	SponsorsCountryOrRegionCodeVu = githubv4.SponsorsCountryOrRegionCodeVu
+	SponsorsCountryOrRegionCodeVa = githubv4.SponsorsCountryOrRegionCodeVa
+	SponsorsCountryOrRegionCodeVe = githubv4.SponsorsCountryOrRegionCodeVe
+	SponsorsCountryOrRegionCodeVn = githubv4.SponsorsCountryOrRegionCodeVn
+	SponsorsCountryOrRegionCodeVg = githubv4.SponsorsCountryOrRegionCodeVg
+	SponsorsCountryOrRegionCodeVi = githubv4.SponsorsCountryOrRegionCodeVi
+	SponsorsCountryOrRegionCodeWf = githubv4.SponsorsCountryOrRegionCodeWf
+	SponsorsCountryOrRegionCodeEh = githubv4.SponsorsCountryOrRegionCodeEh
+	SponsorsCountryOrRegionCodeYe = githubv4.SponsorsCountryOrRegionCodeYe
+	SponsorsCountryOrRegionCodeZm = githubv4.SponsorsCountryOrRegionCodeZm
+	SponsorsCountryOrRegionCodeZw = githubv4.SponsorsCountryOrRegionCodeZw
+)
+
+// SponsorsGoalKind is an alias of githubv4.SponsorsGoalKind.
+type SponsorsGoalKind = githubv4.SponsorsGoalKind
+
+const (
+	SponsorsGoalKindTotalSponsorsCount       = githubv4.SponsorsGoalKindTotalSponsorsCount
+	SponsorsGoalKindMonthlySponsorshipAmount = githubv4.SponsorsGoalKindMonthlySponsorshipAmount
+)
+
+// SponsorsListingFeaturedItemFeatureableType is an alias of githubv4.SponsorsListingFeaturedItemFeatureableType.
+type SponsorsListingFeaturedItemFeatureableType = githubv4.SponsorsListingFeaturedItemFeatureableType
+
+const (
+	SponsorsListingFeaturedItemFeatureableTypeRepository = githubv4.SponsorsListingFeaturedItemFeatureableTypeRepository
+	SponsorsListingFeaturedItemFeatureableTypeUser       = githubv4.SponsorsListingFeaturedItemFeatureableTypeUser
+)
+
+// SponsorsTierOrder is an alias of githubv4.SponsorsTierOrder.
+type SponsorsTierOrder = githubv4.SponsorsTierOrder
+
+// SponsorsTierOrderField is an alias of githubv4.SponsorsTierOrderField.
+type SponsorsTierOrderField = githubv4.SponsorsTierOrderField
+
+const (
+	SponsorsTierOrderFieldCreatedAt           = githubv4.SponsorsTierOrderFieldCreatedAt
+	SponsorsTierOrderFieldMonthlyPriceInCents = githubv4.SponsorsTierOrderFieldMonthlyPriceInCents
+)
+
+// SponsorshipNewsletterOrder is an alias of githubv4.SponsorshipNewsletterOrder.
+type SponsorshipNewsletterOrder = githubv4.SponsorshipNewsletterOrder
+
+// SponsorshipNewsletterOrderField is an alias of githubv4.SponsorshipNewsletterOrderField.
+type SponsorshipNewsletterOrderField = githubv4.SponsorshipNewsletterOrderField
+
+const (
+	SponsorshipNewsletterOrderFieldCreatedAt = githubv4.SponsorshipNewsletterOrderFieldCreatedAt
+)
+
+// SponsorshipOrder is an alias of githubv4.SponsorshipOrder.
+type SponsorshipOrder = githubv4.SponsorshipOrder
+
+// SponsorshipOrderField is an alias of githubv4.SponsorshipOrderField.
+type SponsorshipOrderField = githubv4.SponsorshipOrderField
+
+const (
+	SponsorshipOrderFieldCreatedAt = githubv4.SponsorshipOrderFieldCreatedAt
+)
+
+// SponsorshipPrivacy is an alias of githubv4.SponsorshipPrivacy.
+type SponsorshipPrivacy = githubv4.SponsorshipPrivacy
+
+const (
+	SponsorshipPrivacyPublic  = githubv4.SponsorshipPrivacyPublic
+	SponsorshipPrivacyPrivate = githubv4.SponsorshipPrivacyPrivate
+)
+
+// SquashMergeCommitMessage is an alias of githubv4.SquashMergeCommitMessage.
+type SquashMergeCommitMessage = githubv4.SquashMergeCommitMessage
+
+const (
+	SquashMergeCommitMessagePrBody         = githubv4.SquashMergeCommitMessagePrBody
+	SquashMergeCommitMessageCommitMessages = githubv4.SquashMergeCommitMessageCommitMessages
+	SquashMergeCommitMessageBlank          = githubv4.SquashMergeCommitMessageBlank
+)
+
+// SquashMergeCommitTitle is an alias of githubv4.SquashMergeCommitTitle.
+type SquashMergeCommitTitle = githubv4.SquashMergeCommitTitle
+
+const (
+	SquashMergeCommitTitlePrTitle         = githubv4.SquashMergeCommitTitlePrTitle
+	SquashMergeCommitTitleCommitOrPrTitle = githubv4.SquashMergeCommitTitleCommitOrPrTitle
+)
+
+// StarOrder is an alias of githubv4.StarOrder.
+type StarOrder = githubv4.StarOrder
+
+// StarOrderField is an alias of githubv4.StarOrderField.
+type StarOrderField = githubv4.StarOrderField
+
+const (
+	StarOrderFieldStarredAt = githubv4.StarOrderFieldStarredAt
+)
+
+// StartOrganizationMigrationInput is an alias of githubv4.StartOrganizationMigrationInput.
+type StartOrganizationMigrationInput = githubv4.StartOrganizationMigrationInput
+
+// StartRepositoryMigrationInput is an alias of githubv4.StartRepositoryMigrationInput.
+type StartRepositoryMigrationInput = githubv4.StartRepositoryMigrationInput
+
+// StatusCheckConfigurationInput is an alias of githubv4.StatusCheckConfigurationInput.
+type StatusCheckConfigurationInput = githubv4.StatusCheckConfigurationInput
+
+// StatusState is an alias of githubv4.StatusState.
+type StatusState = githubv4.StatusState
+
+const (
+	StatusStateExpected = githubv4.StatusStateExpected
+	StatusStateError    = githubv4.StatusStateError
+	StatusStateFailure  = githubv4.StatusStateFailure
+	StatusStatePending  = githubv4.StatusStatePending
+	StatusStateSuccess  = githubv4.StatusStateSuccess
+)
+
+// SubmitPullRequestReviewInput is an alias of githubv4.SubmitPullRequestReviewInput.
+type SubmitPullRequestReviewInput = githubv4.SubmitPullRequestReviewInput
+
+// SubscriptionState is an alias of githubv4.SubscriptionState.
+type SubscriptionState = githubv4.SubscriptionState
+
+const (
+	SubscriptionStateUnsubscribed = githubv4.SubscriptionStateUnsubscribed
+	SubscriptionStateSubscribed   = githubv4.SubscriptionStateSubscribed
+	SubscriptionStateIgnored      = githubv4.SubscriptionStateIgnored
+)
+
+// TagNamePatternParametersInput is an alias of githubv4.TagNamePatternParametersInput.
+type TagNamePatternParametersInput = githubv4.TagNamePatternParametersInput
+
+// TeamDiscussionCommentOrder is an alias of githubv4.TeamDiscussionCommentOrder.
+type TeamDiscussionCommentOrder = githubv4.TeamDiscussionCommentOrder
+
+// TeamDiscussionCommentOrderField is an alias of githubv4.TeamDiscussionCommentOrderField.
+type TeamDiscussionCommentOrderField = githubv4.TeamDiscussionCommentOrderField
+
+const (
+	TeamDiscussionCommentOrderFieldNumber = githubv4.TeamDiscussionCommentOrderFieldNumber
+)
+
+// TeamDiscussionOrder is an alias of githubv4.TeamDiscussionOrder.
+type TeamDiscussionOrder = githubv4.TeamDiscussionOrder
+
+// TeamDiscussionOrderField is an alias of githubv4.TeamDiscussionOrderField.
+type TeamDiscussionOrderField = githubv4.TeamDiscussionOrderField
+
+const (
+	TeamDiscussionOrderFieldCreatedAt = githubv4.TeamDiscussionOrderFieldCreatedAt
+)
+
+// TeamMemberOrder is an alias of githubv4.TeamMemberOrder.
+type TeamMemberOrder = githubv4.TeamMemberOrder
+
+// TeamMemberOrderField is an alias of githubv4.TeamMemberOrderField.
+type TeamMemberOrderField = githubv4.TeamMemberOrderField
+
+const (
+	TeamMemberOrderFieldLogin     = githubv4.TeamMemberOrderFieldLogin
+	TeamMemberOrderFieldCreatedAt = githubv4.TeamMemberOrderFieldCreatedAt
+)
+
+// TeamMemberRole is an alias of githubv4.TeamMemberRole.
+type TeamMemberRole = githubv4.TeamMemberRole
+
+const (
+	TeamMemberRoleMaintainer = githubv4.TeamMemberRoleMaintainer
+	TeamMemberRoleMember     = githubv4.TeamMemberRoleMember
+)
+
+// TeamMembershipType is an alias of githubv4.TeamMembershipType.
+type TeamMembershipType = githubv4.TeamMembershipType
+
+const (
+	TeamMembershipTypeImmediate = githubv4.TeamMembershipTypeImmediate
+	TeamMembershipTypeChildTeam = githubv4.TeamMembershipTypeChildTeam
+	TeamMembershipTypeAll       = githubv4.TeamMembershipTypeAll
+)
+
+// TeamNotificationSetting is an alias of githubv4.TeamNotificationSetting.
+type TeamNotificationSetting = githubv4.TeamNotificationSetting
+
+const (
+	TeamNotificationSettingNotificationsEnabled  = githubv4.TeamNotificationSettingNotificationsEnabled
+	TeamNotificationSettingNotificationsDisabled = githubv4.TeamNotificationSettingNotificationsDisabled
+)
+
+// TeamOrder is an alias of githubv4.TeamOrder.
+type TeamOrder = githubv4.TeamOrder
+
+// TeamOrderField is an alias of githubv4.TeamOrderField.
+type TeamOrderField = githubv4.TeamOrderField
+
+const (
+	TeamOrderFieldName = githubv4.TeamOrderFieldName
+)
+
+// TeamPrivacy is an alias of githubv4.TeamPrivacy.
+type TeamPrivacy = githubv4.TeamPrivacy
+
+const (
+	TeamPrivacySecret  = githubv4.TeamPrivacySecret
+	TeamPrivacyVisible = githubv4.TeamPrivacyVisible
+)
+
+// TeamRepositoryOrder is an alias of githubv4.TeamRepositoryOrder.
+type TeamRepositoryOrder = githubv4.TeamRepositoryOrder
+
+// TeamRepositoryOrderField is an alias of githubv4.TeamRepositoryOrderField.
+type TeamRepositoryOrderField = githubv4.TeamRepositoryOrderField
+
+const (
+	TeamRepositoryOrderFieldCreatedAt  = githubv4.TeamRepositoryOrderFieldCreatedAt
+	TeamRepositoryOrderFieldUpdatedAt  = githubv4.TeamRepositoryOrderFieldUpdatedAt
+	TeamRepositoryOrderFieldPushedAt   = githubv4.TeamRepositoryOrderFieldPushedAt
+	TeamRepositoryOrderFieldName       = githubv4.TeamRepositoryOrderFieldName
+	TeamRepositoryOrderFieldPermission = githubv4.TeamRepositoryOrderFieldPermission
+	TeamRepositoryOrderFieldStargazers = githubv4.TeamRepositoryOrderFieldStargazers
+)
+
+// TeamRole is an alias of githubv4.TeamRole.
+type TeamRole = githubv4.TeamRole
+
+const (
+	TeamRoleAdmin  = githubv4.TeamRoleAdmin
+	TeamRoleMember = githubv4.TeamRoleMember
+)
+
+// ThreadSubscriptionFormAction is an alias of githubv4.ThreadSubscriptionFormAction.
+type ThreadSubscriptionFormAction = githubv4.ThreadSubscriptionFormAction
+
+const (
+	ThreadSubscriptionFormActionNone        = githubv4.ThreadSubscriptionFormActionNone
+	ThreadSubscriptionFormActionSubscribe   = githubv4.ThreadSubscriptionFormActionSubscribe
+	ThreadSubscriptionFormActionUnsubscribe = githubv4.ThreadSubscriptionFormActionUnsubscribe
+)
+
+// ThreadSubscriptionState is an alias of githubv4.ThreadSubscriptionState.
+type ThreadSubscriptionState = githubv4.ThreadSubscriptionState
+
+const (
+	ThreadSubscriptionStateUnavailable              = githubv4.ThreadSubscriptionStateUnavailable
+	ThreadSubscriptionStateDisabled                 = githubv4.ThreadSubscriptionStateDisabled
+	ThreadSubscriptionStateIgnoringList             = githubv4.ThreadSubscriptionStateIgnoringList
+	ThreadSubscriptionStateSubscribedToThreadEvents = githubv4.ThreadSubscriptionStateSubscribedToThreadEvents
+	ThreadSubscriptionStateIgnoringThread           = githubv4.ThreadSubscriptionStateIgnoringThread
+	ThreadSubscriptionStateSubscribedToList         = githubv4.ThreadSubscriptionStateSubscribedToList
+	ThreadSubscriptionStateSubscribedToThreadType   = githubv4.ThreadSubscriptionStateSubscribedToThreadType
+	ThreadSubscriptionStateSubscribedToThread       = githubv4.ThreadSubscriptionStateSubscribedToThread
+	ThreadSubscriptionStateNone                     = githubv4.ThreadSubscriptionStateNone
+)
+
+// TopicSuggestionDeclineReason is an alias of githubv4.TopicSuggestionDeclineReason.
+type TopicSuggestionDeclineReason = githubv4.TopicSuggestionDeclineReason
+
+const (
+	TopicSuggestionDeclineReasonNotRelevant        = githubv4.TopicSuggestionDeclineReasonNotRelevant
+	TopicSuggestionDeclineReasonTooSpecific        = githubv4.TopicSuggestionDeclineReasonTooSpecific
+	TopicSuggestionDeclineReasonPersonalPreference = githubv4.TopicSuggestionDeclineReasonPersonalPreference
+	TopicSuggestionDeclineReasonTooGeneral         = githubv4.TopicSuggestionDeclineReasonTooGeneral
+)
+
+// TrackedIssueStates is an alias of githubv4.TrackedIssueStates.
+type TrackedIssueStates = githubv4.TrackedIssueStates
+
+const (
+	TrackedIssueStatesOpen   = githubv4.TrackedIssueStatesOpen
+	TrackedIssueStatesClosed = githubv4.TrackedIssueStatesClosed
+)
+
+// TransferEnterpriseOrganizationInput is an alias of githubv4.TransferEnterpriseOrganizationInput.
+type TransferEnterpriseOrganizationInput = githubv4.TransferEnterpriseOrganizationInput
+
+// TransferIssueInput is an alias of githubv4.TransferIssueInput.
+type TransferIssueInput = githubv4.TransferIssueInput
+
+// UnarchiveProjectV2ItemInput is an alias of githubv4.UnarchiveProjectV2ItemInput.
+type UnarchiveProjectV2ItemInput = githubv4.UnarchiveProjectV2ItemInput
+
+// UnarchiveRepositoryInput is an alias of githubv4.UnarchiveRepositoryInput.
+type UnarchiveRepositoryInput = githubv4.UnarchiveRepositoryInput
+
+// UnfollowOrganizationInput is an alias of githubv4.UnfollowOrganizationInput.
+type UnfollowOrganizationInput = githubv4.UnfollowOrganizationInput
+
+// UnfollowUserInput is an alias of githubv4.UnfollowUserInput.
+type UnfollowUserInput = githubv4.UnfollowUserInput
+
+// UnlinkProjectV2FromRepositoryInput is an alias of githubv4.UnlinkProjectV2FromRepositoryInput.
+type UnlinkProjectV2FromRepositoryInput = githubv4.UnlinkProjectV2FromRepositoryInput
+
+// UnlinkProjectV2FromTeamInput is an alias of githubv4.UnlinkProjectV2FromTeamInput.
+type UnlinkProjectV2FromTeamInput = githubv4.UnlinkProjectV2FromTeamInput
+
+// UnlinkRepositoryFromProjectInput is an alias of githubv4.UnlinkRepositoryFromProjectInput.
+type UnlinkRepositoryFromProjectInput = githubv4.UnlinkRepositoryFromProjectInput
+
+// UnlockLockableInput is an alias of githubv4.UnlockLockableInput.
+type UnlockLockableInput = githubv4.UnlockLockableInput
+
+// UnmarkDiscussionCommentAsAnswerInput is an alias of githubv4.UnmarkDiscussionCommentAsAnswerInput.
+type UnmarkDiscussionCommentAsAnswerInput = githubv4.UnmarkDiscussionCommentAsAnswerInput
+
+// UnmarkFileAsViewedInput is an alias of githubv4.UnmarkFileAsViewedInput.
+type UnmarkFileAsViewedInput = githubv4.UnmarkFileAsViewedInput
+
+// UnmarkIssueAsDuplicateInput is an alias of githubv4.UnmarkIssueAsDuplicateInput.
+type UnmarkIssueAsDuplicateInput = githubv4.UnmarkIssueAsDuplicateInput
+
+// UnmarkProjectV2AsTemplateInput is an alias of githubv4.UnmarkProjectV2AsTemplateInput.
+type UnmarkProjectV2AsTemplateInput = githubv4.UnmarkProjectV2AsTemplateInput
+
+// UnminimizeCommentInput is an alias of githubv4.UnminimizeCommentInput.
+type UnminimizeCommentInput = githubv4.UnminimizeCommentInput
+
+// UnpinIssueInput is an alias of githubv4.UnpinIssueInput.
+type UnpinIssueInput = githubv4.UnpinIssueInput
+
+// UnresolveReviewThreadInput is an alias of githubv4.UnresolveReviewThreadInput.
+type UnresolveReviewThreadInput = githubv4.UnresolveReviewThreadInput
+
+// UpdateBranchProtectionRuleInput is an alias of githubv4.UpdateBranchProtectionRuleInput.
+type UpdateBranchProtectionRuleInput = githubv4.UpdateBranchProtectionRuleInput
+
+// UpdateCheckRunInput is an alias of githubv4.UpdateCheckRunInput.
+type UpdateCheckRunInput = githubv4.UpdateCheckRunInput
+
+// UpdateCheckSuitePreferencesInput is an alias of githubv4.UpdateCheckSuitePreferencesInput.
+type UpdateCheckSuitePreferencesInput = githubv4.UpdateCheckSuitePreferencesInput
+
+// UpdateDiscussionCommentInput is an alias of githubv4.UpdateDiscussionCommentInput.
+type UpdateDiscussionCommentInput = githubv4.UpdateDiscussionCommentInput
+
+// UpdateDiscussionInput is an alias of githubv4.UpdateDiscussionInput.
+type UpdateDiscussionInput = githubv4.UpdateDiscussionInput
+
+// UpdateEnterpriseAdministratorRoleInput is an alias of githubv4.UpdateEnterpriseAdministratorRoleInput.
+type UpdateEnterpriseAdministratorRoleInput = githubv4.UpdateEnterpriseAdministratorRoleInput
+
+// UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput is an alias of githubv4.UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput.
+type UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput = githubv4.UpdateEnterpriseAllowPrivateRepositoryForkingSettingInput
+
+// UpdateEnterpriseDefaultRepositoryPermissionSettingInput is an alias of githubv4.UpdateEnterpriseDefaultRepositoryPermissionSettingInput.
+type UpdateEnterpriseDefaultRepositoryPermissionSettingInput = githubv4.UpdateEnterpriseDefaultRepositoryPermissionSettingInput
+
+// UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput is an alias of githubv4.UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput.
+type UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput = githubv4.UpdateEnterpriseMembersCanChangeRepositoryVisibilitySettingInput
+
+// UpdateEnterpriseMembersCanCreateRepositoriesSettingInput is an alias of githubv4.UpdateEnterpriseMembersCanCreateRepositoriesSettingInput.
+type UpdateEnterpriseMembersCanCreateRepositoriesSettingInput = githubv4.UpdateEnterpriseMembersCanCreateRepositoriesSettingInput
+
+// UpdateEnterpriseMembersCanDeleteIssuesSettingInput is an alias of githubv4.UpdateEnterpriseMembersCanDeleteIssuesSettingInput.
+type UpdateEnterpriseMembersCanDeleteIssuesSettingInput = githubv4.UpdateEnterpriseMembersCanDeleteIssuesSettingInput
+
+// UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput is an alias of githubv4.UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput.
+type UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput = githubv4.UpdateEnterpriseMembersCanDeleteRepositoriesSettingInput
+
+// UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput is an alias of githubv4.UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput.
+type UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput = githubv4.UpdateEnterpriseMembersCanInviteCollaboratorsSettingInput
+
+// UpdateEnterpriseMembersCanMakePurchasesSettingInput is an alias of githubv4.UpdateEnterpriseMembersCanMakePurchasesSettingInput.
+type UpdateEnterpriseMembersCanMakePurchasesSettingInput = githubv4.UpdateEnterpriseMembersCanMakePurchasesSettingInput
+
+// UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput is an alias of githubv4.UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput.
+type UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput = githubv4.UpdateEnterpriseMembersCanUpdateProtectedBranchesSettingInput
+
+// UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput is an alias of githubv4.UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput.
+type UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput = githubv4.UpdateEnterpriseMembersCanViewDependencyInsightsSettingInput
+
+// UpdateEnterpriseOrganizationProjectsSettingInput is an alias of githubv4.UpdateEnterpriseOrganizationProjectsSettingInput.
+type UpdateEnterpriseOrganizationProjectsSettingInput = githubv4.UpdateEnterpriseOrganizationProjectsSettingInput
+
+// UpdateEnterpriseOwnerOrganizationRoleInput is an alias of githubv4.UpdateEnterpriseOwnerOrganizationRoleInput.
+type UpdateEnterpriseOwnerOrganizationRoleInput = githubv4.UpdateEnterpriseOwnerOrganizationRoleInput
+
+// UpdateEnterpriseProfileInput is an alias of githubv4.UpdateEnterpriseProfileInput.
+type UpdateEnterpriseProfileInput = githubv4.UpdateEnterpriseProfileInput
+
+// UpdateEnterpriseRepositoryProjectsSettingInput is an alias of githubv4.UpdateEnterpriseRepositoryProjectsSettingInput.
+type UpdateEnterpriseRepositoryProjectsSettingInput = githubv4.UpdateEnterpriseRepositoryProjectsSettingInput
+
+// UpdateEnterpriseTeamDiscussionsSettingInput is an alias of githubv4.UpdateEnterpriseTeamDiscussionsSettingInput.
+type UpdateEnterpriseTeamDiscussionsSettingInput = githubv4.UpdateEnterpriseTeamDiscussionsSettingInput
+
+// UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput is an alias of githubv4.UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput.
+type UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput = githubv4.UpdateEnterpriseTwoFactorAuthenticationRequiredSettingInput
+
+// UpdateEnvironmentInput is an alias of githubv4.UpdateEnvironmentInput.
+type UpdateEnvironmentInput = githubv4.UpdateEnvironmentInput
+
+// UpdateIPAllowListEnabledSettingInput is an alias of githubv4.UpdateIPAllowListEnabledSettingInput.
+type UpdateIPAllowListEnabledSettingInput = githubv4.UpdateIPAllowListEnabledSettingInput
+
+// UpdateIPAllowListEntryInput is an alias of githubv4.UpdateIPAllowListEntryInput.
+type UpdateIPAllowListEntryInput = githubv4.UpdateIPAllowListEntryInput
+
+// UpdateIPAllowListForInstalledAppsEnabledSettingInput is an alias of githubv4.UpdateIPAllowListForInstalledAppsEnabledSettingInput.
+type UpdateIPAllowListForInstalledAppsEnabledSettingInput = githubv4.UpdateIPAllowListForInstalledAppsEnabledSettingInput
+
+// UpdateIssueCommentInput is an alias of githubv4.UpdateIssueCommentInput.
+type UpdateIssueCommentInput = githubv4.UpdateIssueCommentInput
+
+// UpdateIssueInput is an alias of githubv4.UpdateIssueInput.
+type UpdateIssueInput = githubv4.UpdateIssueInput
+
+// UpdateNotificationRestrictionSettingInput is an alias of githubv4.UpdateNotificationRestrictionSettingInput.
+type UpdateNotificationRestrictionSettingInput = githubv4.UpdateNotificationRestrictionSettingInput
+
+// UpdateOrganizationAllowPrivateRepositoryForkingSettingInput is an alias of githubv4.UpdateOrganizationAllowPrivateRepositoryForkingSettingInput.
+type UpdateOrganizationAllowPrivateRepositoryForkingSettingInput = githubv4.UpdateOrganizationAllowPrivateRepositoryForkingSettingInput
+
+// UpdateOrganizationWebCommitSignoffSettingInput is an alias of githubv4.UpdateOrganizationWebCommitSignoffSettingInput.
+type UpdateOrganizationWebCommitSignoffSettingInput = githubv4.UpdateOrganizationWebCommitSignoffSettingInput
+
+// UpdateParametersInput is an alias of githubv4.UpdateParametersInput.
+type UpdateParametersInput = githubv4.UpdateParametersInput
+
+// UpdateProjectCardInput is an alias of githubv4.UpdateProjectCardInput.
+type UpdateProjectCardInput = githubv4.UpdateProjectCardInput
+
+// UpdateProjectColumnInput is an alias of githubv4.UpdateProjectColumnInput.
+type UpdateProjectColumnInput = githubv4.UpdateProjectColumnInput
+
+// UpdateProjectInput is an alias of githubv4.UpdateProjectInput.
+type UpdateProjectInput = githubv4.UpdateProjectInput
+
+// UpdateProjectV2CollaboratorsInput is an alias of githubv4.UpdateProjectV2CollaboratorsInput.
+type UpdateProjectV2CollaboratorsInput = githubv4.UpdateProjectV2CollaboratorsInput
+
+// UpdateProjectV2DraftIssueInput is an alias of githubv4.UpdateProjectV2DraftIssueInput.
+type UpdateProjectV2DraftIssueInput = githubv4.UpdateProjectV2DraftIssueInput
+
+// UpdateProjectV2Input is an alias of githubv4.UpdateProjectV2Input.
+type UpdateProjectV2Input = githubv4.UpdateProjectV2Input
+
+// UpdateProjectV2ItemFieldValueInput is an alias of githubv4.UpdateProjectV2ItemFieldValueInput.
+type UpdateProjectV2ItemFieldValueInput = githubv4.UpdateProjectV2ItemFieldValueInput
+
+// UpdateProjectV2ItemPositionInput is an alias of githubv4.UpdateProjectV2ItemPositionInput.
+type UpdateProjectV2ItemPositionInput = githubv4.UpdateProjectV2ItemPositionInput
+
+// UpdatePullRequestBranchInput is an alias of githubv4.UpdatePullRequestBranchInput.
+type UpdatePullRequestBranchInput = githubv4.UpdatePullRequestBranchInput
+
+// UpdatePullRequestInput is an alias of githubv4.UpdatePullRequestInput.
+type UpdatePullRequestInput = githubv4.UpdatePullRequestInput
+
+// UpdatePullRequestReviewCommentInput is an alias of githubv4.UpdatePullRequestReviewCommentInput.
+type UpdatePullRequestReviewCommentInput = githubv4.UpdatePullRequestReviewCommentInput
+
+// UpdatePullRequestReviewInput is an alias of githubv4.UpdatePullRequestReviewInput.
+type UpdatePullRequestReviewInput = githubv4.UpdatePullRequestReviewInput
+
+// UpdateRefInput is an alias of githubv4.UpdateRefInput.
+type UpdateRefInput = githubv4.UpdateRefInput
+
+// UpdateRepositoryInput is an alias of githubv4.UpdateRepositoryInput.
+type UpdateRepositoryInput = githubv4.UpdateRepositoryInput
+
+// UpdateRepositoryRulesetInput is an alias of githubv4.UpdateRepositoryRulesetInput.
+type UpdateRepositoryRulesetInput = githubv4.UpdateRepositoryRulesetInput
+
+// UpdateRepositoryWebCommitSignoffSettingInput is an alias of githubv4.UpdateRepositoryWebCommitSignoffSettingInput.
+type UpdateRepositoryWebCommitSignoffSettingInput = githubv4.UpdateRepositoryWebCommitSignoffSettingInput
+
+// UpdateSponsorshipPreferencesInput is an alias of githubv4.UpdateSponsorshipPreferencesInput.
+type UpdateSponsorshipPreferencesInput = githubv4.UpdateSponsorshipPreferencesInput
+
+// UpdateSubscriptionInput is an alias of githubv4.UpdateSubscriptionInput.
+type UpdateSubscriptionInput = githubv4.UpdateSubscriptionInput
+
+// UpdateTeamDiscussionCommentInput is an alias of githubv4.UpdateTeamDiscussionCommentInput.
+type UpdateTeamDiscussionCommentInput = githubv4.UpdateTeamDiscussionCommentInput
+
+// UpdateTeamDiscussionInput is an alias of githubv4.UpdateTeamDiscussionInput.
+type UpdateTeamDiscussionInput = githubv4.UpdateTeamDiscussionInput
+
+// UpdateTeamsRepositoryInput is an alias of githubv4.UpdateTeamsRepositoryInput.
+type UpdateTeamsRepositoryInput = githubv4.UpdateTeamsRepositoryInput
+
+// UpdateTopicsInput is an alias of githubv4.UpdateTopicsInput.
+type UpdateTopicsInput = githubv4.UpdateTopicsInput
+
+// UserBlockDuration is an alias of githubv4.UserBlockDuration.
+type UserBlockDuration = githubv4.UserBlockDuration
+
+const (
+	UserBlockDurationOneDay    = githubv4.UserBlockDurationOneDay
+	UserBlockDurationThreeDays = githubv4.UserBlockDurationThreeDays
+	UserBlockDurationOneWeek   = githubv4.UserBlockDurationOneWeek
+	UserBlockDurationOneMonth  = githubv4.UserBlockDurationOneMonth
+	UserBlockDurationPermanent = githubv4.UserBlockDurationPermanent
+)
+
+// UserStatusOrder is an alias of githubv4.UserStatusOrder.
+type UserStatusOrder = githubv4.UserStatusOrder
+
+// UserStatusOrderField is an alias of githubv4.UserStatusOrderField.
+type UserStatusOrderField = githubv4.UserStatusOrderField
+
+const (
+	UserStatusOrderFieldUpdatedAt = githubv4.UserStatusOrderFieldUpdatedAt
+)
+
+// VerifiableDomainOrder is an alias of githubv4.VerifiableDomainOrder.
+type VerifiableDomainOrder = githubv4.VerifiableDomainOrder
+
+// VerifiableDomainOrderField is an alias of githubv4.VerifiableDomainOrderField.
+type VerifiableDomainOrderField = githubv4.VerifiableDomainOrderField
+
+const (
+	VerifiableDomainOrderFieldDomain    = githubv4.VerifiableDomainOrderFieldDomain
+	VerifiableDomainOrderFieldCreatedAt = githubv4.VerifiableDomainOrderFieldCreatedAt
+)
+
+// VerifyVerifiableDomainInput is an alias of githubv4.VerifyVerifiableDomainInput.
+type VerifyVerifiableDomainInput = githubv4.VerifyVerifiableDomainInput
+
+// WorkflowRunOrder is an alias of githubv4.WorkflowRunOrder.
+type WorkflowRunOrder = githubv4.WorkflowRunOrder
+
+// WorkflowRunOrderField is an alias of githubv4.WorkflowRunOrderField.
+type WorkflowRunOrderField = githubv4.WorkflowRunOrderField
+
+const (
+	WorkflowRunOrderFieldCreatedAt = githubv4.WorkflowRunOrderFieldCreatedAt
+)
+
+// WorkflowState is an alias of githubv4.WorkflowState.
+type WorkflowState = githubv4.WorkflowState
+
+const (
+	WorkflowStateActive             = githubv4.WorkflowStateActive
+	WorkflowStateDeleted            = githubv4.WorkflowStateDeleted
+	WorkflowStateDisabledFork       = githubv4.WorkflowStateDisabledFork
+	WorkflowStateDisabledInactivity = githubv4.WorkflowStateDisabledInactivity
+	WorkflowStateDisabledManually   = githubv4.WorkflowStateDisabledManually
+)
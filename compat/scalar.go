@@ -0,0 +1,309 @@
+package compat
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"time"
+
+	githubv4 "github.com/jbrekelmans/go-githubv4"
+)
+
+// This file defines the scalars of github.com/shurcooL/githubv4, with the same underlying types.
+// Embedded fields of scalars have a graphql tag, so that the embedded fields are not mistaken for inline fragments when
+// response data is decoded.
+// Variable values of these types are converted to the native scalars before they are sent (see convertVariables), so that
+// they are declared and encoded as expected by the GitHub API.
+
+type (
+	// Base64String is a (potentially binary) string encoded using base64.
+	Base64String string
+
+	// BigInt represents non-fractional signed whole numeric values.
+	BigInt = githubv4.BigInt
+
+	// Boolean represents true or false values.
+	Boolean = githubv4.Boolean
+
+	// Date is an ISO-8601 encoded date.
+	Date struct {
+		time.Time `graphql:"-"`
+	}
+
+	// DateTime is an ISO-8601 encoded UTC date.
+	DateTime = githubv4.DateTime
+
+	// Float represents signed double-precision fractional values as
+	// specified by IEEE 754.
+	Float = githubv4.Float
+
+	// GitObjectID is a Git object ID. For example,
+	// "912ec1990bd09f8fc128c3fa6b59105085aabc03".
+	GitObjectID string
+
+	// GitSSHRemote is a Git SSH string.
+	GitSSHRemote string
+
+	// GitTimestamp is an ISO-8601 encoded date.
+	// Unlike the DateTime type, GitTimestamp is not converted in UTC.
+	GitTimestamp struct {
+		time.Time `graphql:"-"`
+	}
+
+	// HTML is a string containing HTML code.
+	HTML string
+
+	// ID represents a unique identifier that is Base64 obfuscated. It
+	// is often used to refetch an object or as key for a cache. The ID
+	// type appears in a JSON response as a String; however, it is not
+	// intended to be human-readable. When expected as an input type,
+	// any string (such as "VXNlci0xMA==") or integer (such as 4) input
+	// value will be accepted as an ID.
+	//
+	// Because ID is an interface type, a variable value of type ID cannot be distinguished from its dynamic value. Use
+	// NewID or a slice of IDs to declare variables as ID.
+	ID any
+
+	// Int represents non-fractional signed whole numeric values.
+	// Int can represent values between -(2^31) and 2^31 - 1.
+	Int = githubv4.Int
+
+	// PreciseDateTime is an ISO-8601 encoded UTC date string with millisecond precision.
+	PreciseDateTime = githubv4.PreciseDateTime
+
+	// String represents textual data as UTF-8 character sequences.
+	// This type is most often used by GraphQL to represent free-form
+	// human-readable text.
+	String = githubv4.String
+
+	// URI is an RFC 3986, RFC 3987, and RFC 6570 (level 4) compliant URI.
+	URI struct {
+		*url.URL `graphql:"-"`
+	}
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// The date is a quoted string in ISO-8601 format.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format("2006-01-02"))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The date is expected to be a quoted string in ISO-8601 format.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The URI is a quoted string.
+func (u URI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The URI is expected to be a quoted string.
+func (u *URI) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := url.Parse(s)
+	if err != nil {
+		return err
+	}
+	u.URL = parsed
+	return nil
+}
+
+// NewBase64String is a helper to make a new *Base64String.
+func NewBase64String(v Base64String) *Base64String { return &v }
+
+// NewBigInt is a helper to make a new *BigInt.
+func NewBigInt(v BigInt) *BigInt { return &v }
+
+// NewBoolean is a helper to make a new *Boolean.
+func NewBoolean(v Boolean) *Boolean { return &v }
+
+// NewDate is a helper to make a new *Date.
+func NewDate(v Date) *Date { return &v }
+
+// NewDateTime is a helper to make a new *DateTime.
+func NewDateTime(v DateTime) *DateTime { return &v }
+
+// NewFloat is a helper to make a new *Float.
+func NewFloat(v Float) *Float { return &v }
+
+// NewGitObjectID is a helper to make a new *GitObjectID.
+func NewGitObjectID(v GitObjectID) *GitObjectID { return &v }
+
+// NewGitSSHRemote is a helper to make a new *GitSSHRemote.
+func NewGitSSHRemote(v GitSSHRemote) *GitSSHRemote { return &v }
+
+// NewGitTimestamp is a helper to make a new *GitTimestamp.
+func NewGitTimestamp(v GitTimestamp) *GitTimestamp { return &v }
+
+// NewHTML is a helper to make a new *HTML.
+func NewHTML(v HTML) *HTML { return &v }
+
+// NewID is a helper to make a new *ID.
+func NewID(v ID) *ID { return &v }
+
+// NewInt is a helper to make a new *Int.
+func NewInt(v Int) *Int { return &v }
+
+// NewPreciseDateTime is a helper to make a new *PreciseDateTime.
+func NewPreciseDateTime(v PreciseDateTime) *PreciseDateTime { return &v }
+
+// NewString is a helper to make a new *String.
+func NewString(v String) *String { return &v }
+
+// NewURI is a helper to make a new *URI.
+func NewURI(v URI) *URI { return &v }
+
+// scalarConversion converts a scalar of this package to the corresponding native scalar.
+type scalarConversion struct {
+	to      reflect.Type
+	convert func(v reflect.Value) any
+}
+
+var scalarConversions = map[reflect.Type]scalarConversion{
+	reflect.TypeOf(Base64String("")): {
+		to: reflect.TypeOf(githubv4.Base64String{}),
+		convert: func(v reflect.Value) any {
+			return githubv4.Base64String{S: v.String()}
+		},
+	},
+	reflect.TypeOf(Date{}): {
+		to: reflect.TypeOf(githubv4.Date{}),
+		convert: func(v reflect.Value) any {
+			return githubv4.Date{S: v.Interface().(Date).Format("2006-01-02")}
+		},
+	},
+	reflect.TypeOf(GitObjectID("")): {
+		to: reflect.TypeOf(githubv4.GitObjectID{}),
+		convert: func(v reflect.Value) any {
+			return githubv4.GitObjectID{S: v.String()}
+		},
+	},
+	reflect.TypeOf(GitSSHRemote("")): {
+		to: reflect.TypeOf(githubv4.GitSSHRemote{}),
+		convert: func(v reflect.Value) any {
+			return githubv4.GitSSHRemote{S: v.String()}
+		},
+	},
+	reflect.TypeOf(GitTimestamp{}): {
+		to: reflect.TypeOf(githubv4.GitTimestamp{}),
+		convert: func(v reflect.Value) any {
+			return githubv4.GitTimestamp{S: v.Interface().(GitTimestamp).Format(time.RFC3339)}
+		},
+	},
+	reflect.TypeOf(HTML("")): {
+		to: reflect.TypeOf(githubv4.HTML{}),
+		convert: func(v reflect.Value) any {
+			return githubv4.HTML{S: v.String()}
+		},
+	},
+	reflect.TypeOf((*ID)(nil)).Elem(): {
+		to: reflect.TypeOf(githubv4.ID{}),
+		convert: func(v reflect.Value) any {
+			if v.IsNil() {
+				return githubv4.ID{}
+			}
+			return githubv4.ID{S: fmt.Sprint(v.Elem().Interface())}
+		},
+	},
+	reflect.TypeOf(URI{}): {
+		to: reflect.TypeOf(githubv4.URI{}),
+		convert: func(v reflect.Value) any {
+			u := v.Interface().(URI)
+			if u.URL == nil {
+				return githubv4.URI{}
+			}
+			return githubv4.URI{S: u.String()}
+		},
+	},
+}
+
+// convertVariables returns a copy of variables where values of scalars of this package (or pointers to or slices of these)
+// are converted to native scalars.
+func convertVariables(variables map[string]any) map[string]any {
+	if variables == nil {
+		return nil
+	}
+	result := make(map[string]any, len(variables))
+	for varName, v := range variables {
+		result[varName] = convertVariable(v)
+	}
+	return result
+}
+
+// convertVariable converts v like convertVariables.
+func convertVariable(v any) any {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return v
+	}
+	if converted, ok := convertValue(rv); ok {
+		return converted.Interface()
+	}
+	return v
+}
+
+// convertValue converts v if its type is a scalar of this package, or a pointer to or slice of such types.
+// Returns false if v need not be converted.
+func convertValue(v reflect.Value) (reflect.Value, bool) {
+	to, ok := convertedType(v.Type())
+	if !ok {
+		return v, false
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(to), true
+		}
+		result := reflect.New(to.Elem())
+		elem, _ := convertValue(v.Elem())
+		result.Elem().Set(elem)
+		return result, true
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(to), true
+		}
+		result := reflect.MakeSlice(to, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, _ := convertValue(v.Index(i))
+			result.Index(i).Set(elem)
+		}
+		return result, true
+	}
+	return reflect.ValueOf(scalarConversions[v.Type()].convert(v)), true
+}
+
+// convertedType returns the type that values of type t are converted to by convertValue.
+// Returns false if values of type t are not converted.
+func convertedType(t reflect.Type) (reflect.Type, bool) {
+	if c, ok := scalarConversions[t]; ok {
+		return c.to, true
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		if to, ok := convertedType(t.Elem()); ok {
+			return reflect.PointerTo(to), true
+		}
+	case reflect.Slice:
+		if to, ok := convertedType(t.Elem()); ok {
+			return reflect.SliceOf(to), true
+		}
+	}
+	return nil, false
+}
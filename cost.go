@@ -0,0 +1,146 @@
+package githubv4
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jbrekelmans/go-graphql/mapping"
+)
+
+// MaxNodeCount is the maximum number of nodes that GitHub allows a query to return.
+// See https://docs.github.com/en/graphql/overview/resource-limitations#node-limit.
+const MaxNodeCount = 500000
+
+// CostAnalysis is the result of AnalyzeCost.
+type CostAnalysis struct {
+	// NodeCount is the maximum number of nodes the query can return, computed using GitHub's formula.
+	// GitHub rejects queries with more than MaxNodeCount nodes.
+	NodeCount int
+
+	// Cost is the rate limit cost of the query, computed using GitHub's formula.
+	Cost int
+}
+
+// NodeLimitError is returned if a query is rejected before it is sent, because its node count exceeds a limit.
+// See WithNodeLimit.
+type NodeLimitError struct {
+	NodeCount int
+	Limit     int
+}
+
+var _ error = (*NodeLimitError)(nil)
+
+// Error implements the error interface.
+func (e *NodeLimitError) Error() string {
+	return fmt.Sprintf(`query has node count %d, which exceeds the limit of %d`, e.NodeCount, e.Limit)
+}
+
+// AnalyzeCost computes the node count and rate limit cost of the query defined by q and variables, without sending anything.
+// See https://docs.github.com/en/graphql/overview/resource-limitations.
+//
+// A field is considered a connection if its graphql struct field tag has a first or last argument. The value of the argument
+// is either an integer literal or a variable, in which case the variable value in variables (see Query) is used.
+// Connections without a first or last argument are not counted, so AnalyzeCost can underestimate queries that GitHub rejects.
+func AnalyzeCost(q any, variables any) (CostAnalysis, error) {
+	vars, err := variablesMap(q, variables, nil)
+	if err != nil {
+		return CostAnalysis{}, err
+	}
+	a := costAnalyzer{
+		variables: vars,
+	}
+	if err := a.selectionSet(reflect.TypeOf(q), 1); err != nil {
+		return CostAnalysis{}, err
+	}
+	cost := int(math.Round(float64(a.requests) / 100))
+	if cost < 1 {
+		cost = 1
+	}
+	return CostAnalysis{
+		NodeCount: a.nodeCount,
+		Cost:      cost,
+	}, nil
+}
+
+type costAnalyzer struct {
+	variables map[string]any
+	nodeCount int
+	requests  int
+}
+
+// selectionSet analyzes the selection set defined by t, where multiplier is the product of the first/last arguments of the
+// connections enclosing the selection set.
+func (a *costAnalyzer) selectionSet(t reflect.Type, multiplier int) error {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || isScalarStruct(t) {
+		return nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		x := mapping.NewFieldInfo(f)
+		fieldMultiplier := multiplier
+		if !x.Inline() && !x.IsInlineFragment() {
+			n, ok, err := a.connectionSize(x.GraphQL())
+			if err != nil {
+				return fmt.Errorf(`error analyzing field %s: %w`, f.Name, err)
+			}
+			if ok {
+				// Each enclosing node requires a request for this connection, and the connection returns up to n nodes for each.
+				a.requests += multiplier
+				fieldMultiplier = multiplier * n
+				a.nodeCount += fieldMultiplier
+			}
+		}
+		if err := a.selectionSet(f.Type, fieldMultiplier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	stringLiteralRegexp = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+	firstLastArgRegexp  = regexp.MustCompile(`[(,\s](?:first|last)\s*:\s*(\$?[_0-9A-Za-z]+)`)
+)
+
+// connectionSize returns the value of the first or last argument of the field defined by GraphQL snippet graphQL.
+// Returns false if the field has neither argument.
+func (a *costAnalyzer) connectionSize(graphQL string) (int, bool, error) {
+	graphQL = stringLiteralRegexp.ReplaceAllString(graphQL, `""`)
+	i := strings.IndexByte(graphQL, '(')
+	if i < 0 {
+		return 0, false, nil
+	}
+	m := firstLastArgRegexp.FindStringSubmatch(graphQL[i:])
+	if m == nil {
+		return 0, false, nil
+	}
+	if !strings.HasPrefix(m[1], "$") {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, false, fmt.Errorf(`invalid first/last argument %s`, m[1])
+		}
+		return n, true, nil
+	}
+	varName := m[1][1:]
+	v := reflect.ValueOf(variableValue(a.variables[varName]))
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(v.Uint()), true, nil
+	}
+	return 0, false, fmt.Errorf(`variable $%s of first/last argument has no integer value`, varName)
+}
@@ -0,0 +1,128 @@
+package githubv4
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AnalyzeCost(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		// Example from https://docs.github.com/en/graphql/overview/resource-limitations.
+		var q struct {
+			Viewer struct {
+				Repositories struct {
+					Nodes []struct {
+						Name   string
+						Issues struct {
+							TotalCount int
+							Nodes      []struct {
+								Title string
+							}
+						} `graphql:"issues(first: 10)"`
+					}
+				} `graphql:"repositories(first: 50)"`
+			}
+		}
+		analysis, err := AnalyzeCost(&q, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, CostAnalysis{NodeCount: 550, Cost: 1}, analysis)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		var q struct {
+			Viewer struct {
+				Repositories struct {
+					Nodes []struct {
+						Issues struct {
+							Nodes []struct {
+								Labels struct {
+									Nodes []struct {
+										Name string
+									}
+								} `graphql:"labels(first: $labels)"`
+							}
+						} `graphql:"issues(last: $issues, labels: [\"first: 1000\"])"`
+					}
+				} `graphql:"repositories(first: 100)"`
+			}
+		}
+		labels := 60
+		analysis, err := AnalyzeCost(&q, map[string]any{
+			"issues": 50,
+			"labels": &labels,
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, CostAnalysis{NodeCount: 305100, Cost: 51}, analysis)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		var q struct {
+			Viewer struct {
+				Repositories struct {
+					TotalCount int
+				} `graphql:"repositories(first: $first)"`
+			}
+		}
+		_, err := AnalyzeCost(&q, nil)
+		assert.ErrorContains(t, err, "variable $first of first/last argument has no integer value")
+	})
+}
+
+func Test_Client_Query_NodeLimit(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		sent := false
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			sent = true
+			return 200, `{"data":{}}`
+		}, WithNodeLimit(1000))
+		var q struct {
+			Search struct {
+				Nodes []struct {
+					Typename string `graphql:"__typename"`
+				}
+			} `graphql:"search(query: $query, type: ISSUE, first: 100)"`
+			Viewer struct {
+				Repositories struct {
+					Nodes []struct {
+						Issues struct {
+							TotalCount int
+						} `graphql:"issues(first: 10)"`
+					}
+				} `graphql:"repositories(first: 100)"`
+			}
+		}
+		_, err := c.Query(context.Background(), &q, map[string]any{
+			"query": "first: 1000",
+		})
+		var nodeLimitErr *NodeLimitError
+		if assert.True(t, errors.As(err, &nodeLimitErr)) {
+			assert.Equal(t, &NodeLimitError{NodeCount: 1200, Limit: 1000}, nodeLimitErr)
+		}
+		assert.False(t, sent)
+	})
+	t.Run("Case2", func(t *testing.T) {
+		// Operations whose node count cannot be determined are sent.
+		sent := false
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			sent = true
+			return 200, `{"data":{"viewer":{"repositories":{"nodes":[]}}}}`
+		}, WithNodeLimit(1000))
+		var q struct {
+			Viewer struct {
+				Repositories struct {
+					Nodes []struct {
+						Name string
+					}
+				} `graphql:"repositories(first: $first)"`
+			}
+		}
+		_, err := c.Query(context.Background(), &q, map[string]any{
+			"first": (*int)(nil),
+		})
+		assert.NoError(t, err)
+		assert.True(t, sent)
+	})
+}
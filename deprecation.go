@@ -0,0 +1,42 @@
+package githubv4
+
+// Deprecation describes a deprecated input object, input field, enum or enum value of the GitHub GraphQL schema.
+// The generated code of deprecated elements has a "Deprecated:" paragraph in its doc comment, so tools like staticcheck and
+// gopls report their usages. Deprecations are read from the isDeprecated and deprecationReason fields of the schema, and from
+// upcoming changes that remove input fields (see https://docs.github.com/en/graphql/overview/breaking-changes).
+type Deprecation struct {
+	// Type is the GraphQL name of the input object or enum, e.g. "CreateTeamDiscussionInput".
+	Type string
+
+	// Field is the GraphQL name of the deprecated input field or enum value, e.g. "teamId". Field is empty if Type is
+	// deprecated.
+	Field string
+
+	// GoName is the name of the deprecated Go type, struct field or constant, e.g. "CreateTeamDiscussionInput.TeamID".
+	GoName string
+
+	// Reason describes why the element is deprecated, and what to use instead (if anything).
+	Reason string
+
+	// RemovalDate is the date on which GitHub removes the element (e.g. "2024-07-01"), or the empty string if unknown.
+	RemovalDate string
+}
+
+// Deprecations returns the deprecated elements of the GitHub GraphQL schema that the package generates code for, in the
+// order of the generated code. An input object or enum is deprecated if all its fields or values (other than
+// clientMutationId) are deprecated for the same reason.
+func Deprecations() []Deprecation {
+	return append([]Deprecation(nil), deprecations...)
+}
+
+// LookupDeprecation returns the deprecation of the input field or enum value named field of the input object or enum named
+// typeName, using GraphQL names. If field is empty then returns the deprecation of the type. Returns false if the element is
+// not deprecated.
+func LookupDeprecation(typeName string, field string) (Deprecation, bool) {
+	for _, d := range deprecations {
+		if d.Type == typeName && d.Field == field {
+			return d, true
+		}
+	}
+	return Deprecation{}, false
+}
@@ -0,0 +1,38 @@
+package githubv4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LookupDeprecation(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		d, ok := LookupDeprecation("CreateTeamDiscussionInput", "")
+		if assert.True(t, ok) {
+			assert.Equal(t, Deprecation{
+				Type:        "CreateTeamDiscussionInput",
+				GoName:      "CreateTeamDiscussionInput",
+				Reason:      "The Team Discussions feature is deprecated in favor of Organization Discussions.",
+				RemovalDate: "2024-07-01",
+			}, d)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		d, ok := LookupDeprecation("AddPullRequestReviewInput", "comments")
+		if assert.True(t, ok) {
+			assert.Equal(t, "AddPullRequestReviewInput.Comments", d.GoName)
+			assert.Equal(t, "2023-10-01", d.RemovalDate)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		_, ok := LookupDeprecation("AddPullRequestReviewInput", "")
+		assert.False(t, ok)
+	})
+	t.Run("Case4", func(t *testing.T) {
+		deprecations := Deprecations()
+		assert.NotEmpty(t, deprecations)
+		deprecations[0].Reason = ""
+		assert.NotEmpty(t, Deprecations()[0].Reason)
+	})
+}
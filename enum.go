@@ -0,0 +1,81 @@
+package githubv4
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+)
+
+// enum is implemented by generated enums.
+type enum interface {
+	~string
+	IsValid() bool
+}
+
+// EnumDecoding configures how generated enums decode values that are not known to this version of the package, e.g. because
+// GitHub added a value to the schema after the package was generated. See SetEnumDecoding.
+type EnumDecoding struct {
+	// KeepUnknown makes generated enums keep unknown values, instead of returning an error.
+	KeepUnknown bool
+
+	// OnUnknown, if not nil, is called with each unknown value that is decoded, e.g. to alert on schema drift.
+	// OnUnknown must be safe for concurrent use.
+	OnUnknown func(UnknownEnumValue)
+}
+
+// UnknownEnumValue is a value of a generated enum that is not known to this version of the package.
+type UnknownEnumValue struct {
+	// Type is the name of the enum, e.g. "CheckConclusionState".
+	Type string
+
+	// Value is the unknown value.
+	Value string
+}
+
+var enumDecoding atomic.Pointer[EnumDecoding]
+
+// SetEnumDecoding configures how generated enums decode unknown values, for all decoding in the process. Generated enums
+// decode with UnmarshalText, both when decoding text (e.g. configuration or flags) and when decoding JSON (including response
+// data of any *Client), so the same configuration applies to all decoding.
+//
+// By default decoding an unknown value returns an error, which fails the whole operation if the value is part of response
+// data. With KeepUnknown the value is kept instead, and the IsKnown method of the enum reports false for it. Programs that
+// must keep working when GitHub adds a value to the schema should set KeepUnknown. OnUnknown is called with each unknown
+// value in either case.
+//
+// The configuration is process-global: it also applies to other packages and libraries in the process that use this
+// package, and there is no per-client configuration. Libraries should not call SetEnumDecoding; leave it to the main
+// program, which should call it during initialization, for example:
+//
+//	githubv4.SetEnumDecoding(githubv4.EnumDecoding{
+//		KeepUnknown: true,
+//		OnUnknown: func(v githubv4.UnknownEnumValue) {
+//			log.Printf("unknown %s value %q", v.Type, v.Value)
+//		},
+//	})
+func SetEnumDecoding(d EnumDecoding) {
+	enumDecoding.Store(&d)
+}
+
+// unmarshalEnum sets *x to text, or returns an error if text is not a value of enum E (unless unknown values are kept, see
+// SetEnumDecoding). Generated enums implement encoding.TextUnmarshaler using unmarshalEnum.
+func unmarshalEnum[E enum](x *E, text []byte) error {
+	var d EnumDecoding
+	if p := enumDecoding.Load(); p != nil {
+		d = *p
+	}
+	v := E(text)
+	if !v.IsValid() {
+		if d.OnUnknown != nil {
+			d.OnUnknown(UnknownEnumValue{
+				Type:  reflect.TypeOf(v).Name(),
+				Value: string(v),
+			})
+		}
+		if !d.KeepUnknown {
+			return fmt.Errorf(`invalid %T value %#v`, v, string(text))
+		}
+	}
+	*x = v
+	return nil
+}
@@ -0,0 +1,124 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_enum(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		assert.Equal(t, []ActorType{ActorTypeUser, ActorTypeTeam}, ActorType("").Values())
+		assert.True(t, ActorTypeTeam.IsValid())
+		assert.False(t, ActorType("BOT").IsValid())
+		assert.Equal(t, "TEAM", ActorTypeTeam.String())
+		assert.Equal(t, "Indicates a team actor.", ActorTypeTeam.Description())
+		assert.Equal(t, "", ActorType("BOT").Description())
+	})
+	t.Run("Case2", func(t *testing.T) {
+		var x struct {
+			Type ActorType
+		}
+		if assert.NoError(t, json.Unmarshal([]byte(`{"Type":"TEAM"}`), &x)) {
+			assert.Equal(t, ActorTypeTeam, x.Type)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		var x ActorType
+		err := x.UnmarshalText([]byte("BOT"))
+		if assert.Error(t, err) {
+			assert.Equal(t, `invalid githubv4.ActorType value "BOT"`, err.Error())
+		}
+		assert.Equal(t, ActorType(""), x)
+	})
+	t.Run("Case4", func(t *testing.T) {
+		var mu sync.Mutex
+		var unknown []UnknownEnumValue
+		SetEnumDecoding(EnumDecoding{
+			KeepUnknown: true,
+			OnUnknown: func(v UnknownEnumValue) {
+				mu.Lock()
+				defer mu.Unlock()
+				unknown = append(unknown, v)
+			},
+		})
+		t.Cleanup(func() {
+			SetEnumDecoding(EnumDecoding{})
+		})
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"node":{"conclusion":"EXPLODED"}}}`
+		})
+		var q struct {
+			Node struct {
+				Conclusion CheckConclusionState
+			} `graphql:"node(id: \"C0\")"`
+		}
+		_, err := c.Query(context.Background(), &q, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, CheckConclusionState("EXPLODED"), q.Node.Conclusion)
+			assert.False(t, q.Node.Conclusion.IsKnown())
+			assert.Equal(t, []UnknownEnumValue{{Type: "CheckConclusionState", Value: "EXPLODED"}}, unknown)
+		}
+	})
+	t.Run("Case5", func(t *testing.T) {
+		var unknown []UnknownEnumValue
+		SetEnumDecoding(EnumDecoding{
+			OnUnknown: func(v UnknownEnumValue) {
+				unknown = append(unknown, v)
+			},
+		})
+		t.Cleanup(func() {
+			SetEnumDecoding(EnumDecoding{})
+		})
+		var x ActorType
+		assert.Error(t, x.UnmarshalText([]byte("BOT")))
+		assert.Equal(t, []UnknownEnumValue{{Type: "ActorType", Value: "BOT"}}, unknown)
+	})
+	t.Run("Case6", func(t *testing.T) {
+		// By default response data with an unknown value fails to decode, like UnmarshalText. Null values decode.
+		for _, tc := range []struct {
+			conclusion string
+			errMessage string
+		}{
+			{conclusion: `"EXPLODED"`, errMessage: `invalid githubv4.CheckConclusionState value "EXPLODED"`},
+			{conclusion: `null`},
+		} {
+			c := newTestClient(t, func(req testRequest) (int, string) {
+				return http.StatusOK, `{"data":{"node":{"conclusion":` + tc.conclusion + `}}}`
+			})
+			var q struct {
+				Node struct {
+					Conclusion *CheckConclusionState
+				} `graphql:"node(id: \"C0\")"`
+			}
+			_, err := c.Query(context.Background(), &q, nil)
+			if tc.errMessage != "" {
+				assert.ErrorContains(t, err, tc.errMessage)
+			} else if assert.NoError(t, err) {
+				assert.Nil(t, q.Node.Conclusion)
+			}
+		}
+	})
+	t.Run("Case7", func(t *testing.T) {
+		SetEnumDecoding(EnumDecoding{
+			KeepUnknown: true,
+		})
+		t.Cleanup(func() {
+			SetEnumDecoding(EnumDecoding{})
+		})
+		var x struct {
+			Type ActorType
+		}
+		if assert.NoError(t, json.Unmarshal([]byte(`{"Type":"BOT"}`), &x)) {
+			assert.Equal(t, ActorType("BOT"), x.Type)
+		}
+		var y ActorType
+		if assert.NoError(t, y.UnmarshalText([]byte("BOT"))) {
+			assert.Equal(t, ActorType("BOT"), y)
+		}
+	})
+}
@@ -29,6 +29,14 @@ func enhanceError(err error) error {
 	return enhanced
 }
 
+// setOperationName sets the OperationName of err if err is an *Error, and returns err.
+func setOperationName(err error, operationName string) error {
+	if gErr, ok := err.(*Error); ok {
+		gErr.OperationName = operationName
+	}
+	return err
+}
+
 func enhanceErrorItem(base graphql.ErrorItem) ErrorItem {
 	return ErrorItem{
 		Extensions: tryGet[map[string]any](base.Raw, "extensions"),
@@ -52,6 +60,13 @@ type Error struct {
 
 	// Operation is the GraphQL query/mutation/operation for which the error occurred.
 	Operation string
+
+	// OperationName is the name of Operation, or the empty string if Operation is anonymous.
+	// See OperationNamer and WithOperationName.
+	OperationName string
+
+	// ClientMutationIDs maps names of input variables to client mutation IDs. See Metadata.
+	ClientMutationIDs map[string]string
 }
 
 var _ error = (*Error)(nil)
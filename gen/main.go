@@ -1,24 +1,72 @@
+// Command gen generates ../gen.go and ../compat/gen.go from the GitHub GraphQL schema.
+//
+// By default gen fetches the schema from https://api.github.com/graphql, authenticating with the GITHUB_TOKEN environment
+// variable. Flag -save-schema writes the fetched schema to a file, so that it can be committed. Flag -schema reads the
+// schema from a file instead, either an introspection result (JSON) or a schema in the GraphQL schema definition language
+// (if the file name ends with .graphql or .graphqls), so that the generator runs offline. The generated code only depends
+// on the schema, so generating twice from the same schema gives byte-for-byte identical files.
+//
+// The schema snapshot schema.json is committed. Run go generate in the root package to regenerate the files from it; CI
+// fails if the committed files differ from the regenerated ones. The Gen workflow updates the snapshot daily.
 package main
 
 import (
 	"bytes"
 	"encoding/json"
+	"flag"
 	"fmt"
+	"go/format"
 	"io"
 	"log"
 	"net/http"
 	"os"
-	"os/exec"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/shurcooL/graphql/ident"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
 	"golang.org/x/oauth2"
 )
 
 func main() {
+	schemaPath := flag.String("schema", "", "read the schema from `file` instead of fetching it from GitHub")
+	saveSchemaPath := flag.String("save-schema", "", "write the schema fetched from GitHub to `file`")
+	outDir := flag.String("out", "..", "write generated files to the root package in `dir`")
+	flag.Parse()
+	if *schemaPath != "" && *saveSchemaPath != "" {
+		log.Fatal("flags -schema and -save-schema are mutually exclusive")
+	}
+
+	var types []*Type
+	var err error
+	if *schemaPath != "" {
+		types, err = readSchemaFile(*schemaPath)
+	} else {
+		types, err = fetchSchema(*saveSchemaPath)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	genSrc, compatSrc, err := generate(types)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*outDir, "gen.go"), genSrc, 0600); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*outDir, "compat", "gen.go"), compatSrc, 0600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// fetchSchema fetches the schema from GitHub. If savePath is not empty then fetchSchema also writes the introspection
+// result to the file at savePath.
+func fetchSchema(savePath string) ([]*Type, error) {
 	transport := &oauth2.Transport{
 		Source: oauth2.StaticTokenSource(&oauth2.Token{
 			AccessToken: os.Getenv("GITHUB_TOKEN"),
@@ -28,36 +76,173 @@ func main() {
 	client := &http.Client{Transport: transport}
 	resp, err := client.Get("https://api.github.com/graphql")
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	respBodyBytes, err := io.ReadAll(resp.Body)
 	_ = resp.Body.Close()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	var respBody struct {
-		Data struct {
-			Schema struct {
-				MutationType     any     `json:"mutationType"`
-				SubscriptionType any     `json:"subscriptionType"`
-				Types            []*Type `json:"types"`
-				QueryType        any     `json:"queryType"`
-				Directives       []any   `json:"directives"`
-			} `json:"__schema"`
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching schema: unexpected status %s", resp.Status)
+	}
+	types, err := decodeIntrospection(respBodyBytes, true)
+	if err != nil {
+		return nil, err
+	}
+	if savePath != "" {
+		// Indent the schema so that diffs of the committed file are readable.
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, respBodyBytes, "", "  "); err != nil {
+			return nil, err
+		}
+		indented.WriteByte('\n')
+		if err := os.WriteFile(savePath, indented.Bytes(), 0600); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
+
+// readSchemaFile reads the schema from the file at path. The file is parsed as schema definition language if its name ends
+// with .graphql or .graphqls, and as an introspection result otherwise.
+func readSchemaFile(path string) ([]*Type, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch filepath.Ext(path) {
+	case ".graphql", ".graphqls":
+		return parseSDL(path, string(b))
+	}
+	return decodeIntrospection(b, false)
+}
+
+// decodeIntrospection decodes the types of an introspection result, either a GraphQL response ({"data": {"__schema": ...}})
+// or its data. If strict is true then unknown fields are errors, so that changes to the introspection format of GitHub
+// are noticed.
+func decodeIntrospection(b []byte, strict bool) ([]*Type, error) {
+	type schema struct {
+		MutationType     any     `json:"mutationType"`
+		SubscriptionType any     `json:"subscriptionType"`
+		Types            []*Type `json:"types"`
+		QueryType        any     `json:"queryType"`
+		Directives       []any   `json:"directives"`
+	}
+	var body struct {
+		Data *struct {
+			Schema *schema `json:"__schema"`
 		} `json:"data"`
+		Schema *schema `json:"__schema"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(&body); err != nil {
+		return nil, fmt.Errorf("decoding introspection result: %w", err)
+	}
+	s := body.Schema
+	if body.Data != nil && body.Data.Schema != nil {
+		s = body.Data.Schema
 	}
-	decoder := json.NewDecoder(bytes.NewReader(respBodyBytes))
-	decoder.DisallowUnknownFields()
-	err = decoder.Decode(&respBody)
+	if s == nil {
+		return nil, fmt.Errorf("decoding introspection result: no __schema")
+	}
+	return s.Types, nil
+}
+
+// parseSDL parses a schema in the GraphQL schema definition language, and converts its types to the types of an
+// introspection result.
+func parseSDL(name, input string) ([]*Type, error) {
+	s, err := gqlparser.LoadSchema(&ast.Source{Name: name, Input: input})
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	names := make([]string, 0, len(s.Types))
+	for n := range s.Types {
+		names = append(names, n)
 	}
+	sort.Strings(names)
+	types := make([]*Type, 0, len(names))
+	for _, n := range names {
+		def := s.Types[n]
+		t := &Type{
+			Description: def.Description,
+			Kind:        string(def.Kind),
+			Name:        def.Name,
+		}
+		for _, v := range def.EnumValues {
+			isDeprecated, reason := sdlDeprecation(v.Directives)
+			t.EnumValues = append(t.EnumValues, EnumValue{
+				Description:  v.Description,
+				IsDeprecated: isDeprecated,
+				Deprecated:   reason,
+				Name:         v.Name,
+			})
+		}
+		if def.Kind == ast.InputObject {
+			for _, f := range def.Fields {
+				t.InputFields = append(t.InputFields, sdlInputField(s, f))
+			}
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// sdlInputField converts an input field definition to an InputField.
+func sdlInputField(s *ast.Schema, f *ast.FieldDefinition) InputField {
+	isDeprecated, reason := sdlDeprecation(f.Directives)
+	field := InputField{
+		Description:  f.Description,
+		IsDeprecated: isDeprecated,
+		Deprecated:   reason,
+		Name:         f.Name,
+		Type:         sdlTypeRef(s, f.Type),
+	}
+	if f.DefaultValue != nil {
+		field.DefaultValue = f.DefaultValue.String()
+	}
+	return field
+}
 
-	typeByName := make(map[string]*Type, len(respBody.Data.Schema.Types))
-	for _, t := range respBody.Data.Schema.Types {
+// sdlDeprecation returns whether directives contain @deprecated, and its reason.
+func sdlDeprecation(directives ast.DirectiveList) (bool, string) {
+	d := directives.ForName("deprecated")
+	if d == nil {
+		return false, ""
+	}
+	reason := "No longer supported"
+	if arg := d.Arguments.ForName("reason"); arg != nil && arg.Value != nil {
+		reason = arg.Value.Raw
+	}
+	return true, reason
+}
+
+// sdlTypeRef converts a type reference of the schema definition language to a TypeRef.
+func sdlTypeRef(s *ast.Schema, t *ast.Type) TypeRef {
+	var ref TypeRef
+	if t.Elem != nil {
+		elem := sdlTypeRef(s, t.Elem)
+		ref = TypeRef{Kind: "LIST", OfType: &elem}
+	} else {
+		name := t.NamedType
+		ref = TypeRef{Kind: string(s.Types[name].Kind), Name: &name}
+	}
+	if t.NonNull {
+		return TypeRef{Kind: "NON_NULL", OfType: &ref}
+	}
+	return ref
+}
+
+// generate returns the source code of gen.go and compat/gen.go for the types of the schema.
+func generate(types []*Type) (genSrc, compatSrc []byte, err error) {
+	typeByName := make(map[string]*Type, len(types))
+	for _, t := range types {
 		_, ok := typeByName[t.Name]
 		if ok {
-			log.Fatalf("two types named %#v\n", t.Name)
+			return nil, nil, fmt.Errorf("two types named %#v", t.Name)
 		}
 		typeByName[t.Name] = t
 	}
@@ -131,6 +316,13 @@ func main() {
 	sort.Slice(typesToOutput, func(i, j int) bool {
 		return typesToOutput[i].Name < typesToOutput[j].Name
 	})
+	kindByGoTypeName := make(map[string]string, len(typesToOutput))
+	for _, t := range typesToOutput {
+		kindByGoTypeName[toGoTypeName(t.Name)] = t.Kind
+	}
+	kindOf := func(goTypeName string) string {
+		return kindByGoTypeName[goTypeName]
+	}
 	buf := new(bytes.Buffer)
 	printfIndent := 0
 	printf := func(format string, args ...any) {
@@ -143,6 +335,15 @@ func main() {
 			printf("// %s\n", line)
 		}
 	}
+	deprecatedComment := func(d deprecation) {
+		printf("//\n")
+		multiLineComment("Deprecated: " + d.reason)
+	}
+	var registry []string
+	register := func(d deprecation, typeName, field, goName string) {
+		registry = append(registry, fmt.Sprintf("{Type: %q, Field: %q, GoName: %q, Reason: %q, RemovalDate: %q},",
+			typeName, field, goName, d.reason, d.removalDate))
+	}
 	printf("package githubv4\n\n")
 	for _, t := range typesToOutput {
 		descrTrimmed := strings.TrimSpace(t.Description)
@@ -163,23 +364,39 @@ func main() {
 			comment += "."
 		}
 		multiLineComment(comment)
+		deprecations, typeDeprecated := deprecationsOf(t)
+		if typeDeprecated != nil {
+			deprecatedComment(*typeDeprecated)
+			register(*typeDeprecated, t.Name, "", goTypeName)
+		}
 		switch t.Kind {
 		case "INPUT_OBJECT":
 			printf("type %s struct {\n", goTypeName)
 			printfIndent++
-			for _, inputField := range t.InputFields {
+			hasOptional := false
+			var validation []string
+			for i, inputField := range t.InputFields {
 				goFieldName := toGoTypeName(inputField.Name)
 				descrTrimmed := strings.TrimSpace(inputField.Description)
 				if !strings.HasSuffix(descrTrimmed, ".") {
 					descrTrimmed += "."
 				}
 				multiLineComment(descrTrimmed)
+				if d := deprecations[i]; d != nil {
+					deprecatedComment(*d)
+					register(*d, t.Name, inputField.Name, goTypeName+"."+goFieldName)
+				}
 				goType := toGoType(&inputField.Type)
 				jsonTag := inputField.Name
 				if strings.HasPrefix(goType, "*") {
-					jsonTag += ",omitempty"
+					// Nullable fields are Optional, so that unset fields are omitted and null can be sent explicitly. Unset
+					// fields are omitted by marshalInput.
+					goType = "Optional[" + goType[1:] + "]"
+					hasOptional = true
 				}
 				printf("%s %s %#v\n", goFieldName, goType, fmt.Sprintf(`json:%#v`, jsonTag))
+				validation = append(validation, validationCode("x."+goFieldName, goType, fmt.Sprintf("%q", inputField.Name), true,
+					kindOf)...)
 			}
 			printfIndent--
 			printf("}\n\n")
@@ -187,31 +404,284 @@ func main() {
 			printf("var _ Input = (*%s)(nil)\n\n", goTypeName)
 			printf("// isInput implements the Input interface.\n")
 			printf("func (%s) isInput() {}\n\n", goTypeName)
+			if hasOptional {
+				printf("// MarshalJSON implements json.Marshaler, omitting unset optional fields.\n")
+				printf("func (x %s) MarshalJSON() ([]byte, error) {\n", goTypeName)
+				printf("\treturn marshalInput(x)\n")
+				printf("}\n\n")
+			}
+			printf("// Validate returns a *ValidationError if a non-null field of x (or of an input object in x) is missing, or if an\n")
+			printf("// enum field has an invalid value.\n")
+			printf("func (x %s) Validate() error {\n", goTypeName)
+			printf("\treturn validateInput(\"\", x)\n")
+			printf("}\n\n")
+			printf("// validate implements the inputValidator interface.\n")
+			if len(validation) == 0 {
+				printf("func (%s) validate(*validator) {}\n\n", goTypeName)
+			} else {
+				printf("func (x %s) validate(v *validator) {\n", goTypeName)
+				for _, line := range validation {
+					printf("%s\n", line)
+				}
+				printf("}\n\n")
+			}
 		case "ENUM":
 			printf("type %s string\n\n", goTypeName)
 			printf("const (\n")
 			printfIndent++
-			for _, enumValue := range t.EnumValues {
+			constNames := make([]string, 0, len(t.EnumValues))
+			descriptions := make([]string, 0, len(t.EnumValues))
+			for i, enumValue := range t.EnumValues {
 				constName := goTypeName + ident.ParseScreamingSnakeCase(enumValue.Name).ToMixedCaps()
-				comment := constName + ". " + strings.TrimSpace(enumValue.Description)
-				if !strings.HasSuffix(comment, ".") {
-					comment += "."
+				descr := strings.TrimSpace(enumValue.Description)
+				if !strings.HasSuffix(descr, ".") {
+					descr += "."
+				}
+				multiLineComment(constName + ". " + descr)
+				if d := deprecations[i]; d != nil {
+					deprecatedComment(*d)
+					register(*d, t.Name, enumValue.Name, constName)
 				}
-				multiLineComment(comment)
 				printf("%s %s = %#v\n", constName, goTypeName, enumValue.Name)
+				constNames = append(constNames, constName)
+				descriptions = append(descriptions, descr)
 			}
 			printfIndent--
 			printf(")\n\n")
+			printf("// Values returns the values of %s.\n", goTypeName)
+			printf("func (%s) Values() []%s {\n", goTypeName, goTypeName)
+			printf("\treturn []%s{%s}\n", goTypeName, strings.Join(constNames, ", "))
+			printf("}\n\n")
+			printf("// IsValid returns true if x is a value of %s.\n", goTypeName)
+			printf("func (x %s) IsValid() bool {\n", goTypeName)
+			printf("\tswitch x {\n")
+			printf("\tcase %s:\n", strings.Join(constNames, ", "))
+			printf("\t\treturn true\n")
+			printf("\t}\n")
+			printf("\treturn false\n")
+			printf("}\n\n")
+			printf("// IsKnown returns true if x is a value of %s that is known to this version of the package. Decoded values are\n", goTypeName)
+			printf("// unknown if GitHub added them to the schema after the package was generated. See SetEnumDecoding.\n")
+			printf("// IsKnown is an alias of IsValid, named for checking decoded values.\n")
+			printf("func (x %s) IsKnown() bool {\n", goTypeName)
+			printf("\treturn x.IsValid()\n")
+			printf("}\n\n")
+			printf("// String implements fmt.Stringer.\n")
+			printf("func (x %s) String() string {\n", goTypeName)
+			printf("\treturn string(x)\n")
+			printf("}\n\n")
+			printf("// Description returns the description of x in the GitHub GraphQL schema, or the empty string if x is not a value of\n")
+			printf("// %s.\n", goTypeName)
+			printf("func (x %s) Description() string {\n", goTypeName)
+			printf("\tswitch x {\n")
+			for i, constName := range constNames {
+				printf("\tcase %s:\n", constName)
+				printf("\t\treturn %q\n", descriptions[i])
+			}
+			printf("\t}\n")
+			printf("\treturn \"\"\n")
+			printf("}\n\n")
+			printf("// UnmarshalText implements encoding.TextUnmarshaler, also for decoding JSON. Values that are not a value of %s\n", goTypeName)
+			printf("// are decoded as configured by SetEnumDecoding.\n")
+			printf("func (x *%s) UnmarshalText(text []byte) error {\n", goTypeName)
+			printf("\treturn unmarshalEnum(x, text)\n")
+			printf("}\n\n")
 		}
 	}
-	if err := os.WriteFile("../gen.go", buf.Bytes(), 0600); err != nil {
-		log.Fatal(err)
+	printf("// deprecations are the deprecated elements of the schema. See Deprecations.\n")
+	printf("var deprecations = []Deprecation{\n")
+	for _, entry := range registry {
+		printf("\t%s\n", entry)
 	}
-	cmd := exec.Command("gofmt", "-w", "../gen.go")
-	_, err = cmd.CombinedOutput()
+	printf("}\n")
+	genSrc, err = format.Source(buf.Bytes())
 	if err != nil {
-		log.Fatal(err)
+		return nil, nil, fmt.Errorf("formatting gen.go: %w", err)
+	}
+
+	// Package compat aliases input objects and enums, so that code using github.com/shurcooL/githubv4 compiles with
+	// package compat. Deprecated input objects, enums and enum values are not aliased, because aliasing them uses deprecated
+	// identifiers (see staticcheck SA1019).
+	buf.Reset()
+	printf("package compat\n\n")
+	printf("import githubv4 \"github.com/jbrekelmans/go-githubv4\"\n\n")
+	for _, t := range typesToOutput {
+		deprecations, typeDeprecated := deprecationsOf(t)
+		if typeDeprecated != nil {
+			continue
+		}
+		goTypeName := toGoTypeName(t.Name)
+		printf("// %s is an alias of githubv4.%s.\n", goTypeName, goTypeName)
+		printf("type %s = githubv4.%s\n\n", goTypeName, goTypeName)
+		if t.Kind == "ENUM" {
+			printf("const (\n")
+			printfIndent++
+			for i, enumValue := range t.EnumValues {
+				if deprecations[i] != nil {
+					continue
+				}
+				constName := goTypeName + ident.ParseScreamingSnakeCase(enumValue.Name).ToMixedCaps()
+				printf("%s = githubv4.%s\n", constName, constName)
+			}
+			printfIndent--
+			printf(")\n\n")
+		}
+	}
+	compatSrc, err = format.Source(buf.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf("formatting compat/gen.go: %w", err)
+	}
+	return genSrc, compatSrc, nil
+}
+
+// validationCode returns the statements of the validate method of an input object that check value x of Go type goType
+// (see toGoType). nameExpr is a Go expression of the name of x in field paths, e.g. "\"labelIds\"". nonNull is true if x is
+// a list that must not be nil. kindOf returns the kind of a generated Go type, e.g. "ENUM", or the empty string for scalars.
+// Statements are not indented, because the generated code is formatted.
+func validationCode(x string, goType string, nameExpr string, nonNull bool, kindOf func(goTypeName string) string) []string {
+	if strings.HasPrefix(goType, "Optional[") {
+		check := validationCode("y", goType[len("Optional["):len(goType)-1], nameExpr, false, kindOf)
+		if len(check) == 0 {
+			return nil
+		}
+		lines := []string{fmt.Sprintf("if y, ok := %s.Value(); ok {", x)}
+		lines = append(lines, check...)
+		return append(lines, "}")
+	}
+	if strings.HasPrefix(goType, "[]") {
+		var lines []string
+		if nonNull {
+			lines = append(lines, fmt.Sprintf("if %s == nil {", x), fmt.Sprintf("v.missing(%s)", nameExpr), "}")
+		}
+		elemType := goType[len("[]"):]
+		elemNameExpr := fmt.Sprintf("index(%s, i)", nameExpr)
+		var check []string
+		if strings.HasPrefix(elemType, "*") {
+			check = validationCode("(*e)", elemType[1:], elemNameExpr, true, kindOf)
+			if len(check) > 0 {
+				check = append(append([]string{"if e != nil {"}, check...), "}")
+			}
+		} else {
+			check = validationCode("e", elemType, elemNameExpr, true, kindOf)
+		}
+		if len(check) > 0 {
+			lines = append(lines, fmt.Sprintf("for i, e := range %s {", x))
+			lines = append(lines, check...)
+			lines = append(lines, "}")
+		}
+		return lines
+	}
+	switch kindOf(goType) {
+	case "ENUM":
+		return []string{fmt.Sprintf("v.enum(%s, string(%s), %s.IsValid())", nameExpr, x, x)}
+	case "INPUT_OBJECT":
+		return []string{fmt.Sprintf("v.input(%s, %s)", nameExpr, x)}
+	}
+	switch goType {
+	case "ID", "GitObjectID", "URI", "Base64String", "Date":
+		return []string{fmt.Sprintf("if %s.S == \"\" {", x), fmt.Sprintf("v.missing(%s)", nameExpr), "}"}
+	case "DateTime":
+		return []string{fmt.Sprintf("if %s.IsZero() {", x), fmt.Sprintf("v.missing(%s)", nameExpr), "}"}
+	}
+	return nil
+}
+
+// deprecation is the deprecation of an input field or enum value.
+type deprecation struct {
+	// reason describes why the input field or enum value is deprecated.
+	reason string
+
+	// typeReason describes why the input object or enum is deprecated, if all its fields or values are deprecated for the
+	// same reason.
+	typeReason string
+
+	// removalDate is the date on which GitHub removes the input field or enum value, if known.
+	removalDate string
+}
+
+// upcomingRemovalRegexp matches the description of an input field that GitHub removes in an upcoming change.
+// See https://docs.github.com/en/graphql/overview/breaking-changes.
+var upcomingRemovalRegexp = regexp.MustCompile(
+	`(?s)\*\*Upcoming Change on (\d{4}-\d{2}-\d{2}) UTC\*\*\s*\*\*Description:\*\*\s*(.*?will be removed.*?)\s*\*\*Reason:\*\*\s*(.*?)\s*$`)
+
+// inputFieldDeprecation returns the deprecation of f. Returns false if f is not deprecated, and is not removed by an upcoming
+// change.
+func inputFieldDeprecation(f InputField) (deprecation, bool) {
+	if f.IsDeprecated {
+		reason := deprecationReason(f.Deprecated)
+		return deprecation{reason: reason, typeReason: reason}, true
+	}
+	m := upcomingRemovalRegexp.FindStringSubmatch(strings.TrimSpace(f.Description))
+	if m == nil {
+		return deprecation{}, false
+	}
+	return deprecation{
+		reason:      deprecationReason(m[2]) + " " + deprecationReason(m[3]),
+		typeReason:  deprecationReason(m[3]),
+		removalDate: m[1],
+	}, true
+}
+
+// enumValueDeprecation returns the deprecation of v. Returns false if v is not deprecated.
+func enumValueDeprecation(v EnumValue) (deprecation, bool) {
+	if !v.IsDeprecated {
+		return deprecation{}, false
+	}
+	reason := deprecationReason(v.Deprecated)
+	return deprecation{reason: reason, typeReason: reason}, true
+}
+
+// deprecationsOf returns the deprecations of the fields (or values) of t by index, which are nil for fields or values that
+// are not deprecated, and the deprecation of t, which is nil if t is not deprecated. A type has either fields or values.
+func deprecationsOf(t *Type) (deprecations []*deprecation, typeDeprecated *deprecation) {
+	deprecations = make([]*deprecation, len(t.InputFields)+len(t.EnumValues))
+	var typeElemDeprecations []*deprecation
+	for i, inputField := range t.InputFields {
+		if d, ok := inputFieldDeprecation(inputField); ok {
+			deprecations[i] = &d
+		}
+		if inputField.Name != "clientMutationId" {
+			typeElemDeprecations = append(typeElemDeprecations, deprecations[i])
+		}
+	}
+	for i, enumValue := range t.EnumValues {
+		if d, ok := enumValueDeprecation(enumValue); ok {
+			deprecations[i] = &d
+		}
+		typeElemDeprecations = append(typeElemDeprecations, deprecations[i])
+	}
+	if d, ok := typeDeprecation(typeElemDeprecations); ok {
+		typeDeprecated = &d
+	}
+	return deprecations, typeDeprecated
+}
+
+// typeDeprecation returns the deprecation of an input object or enum, given the deprecations of its fields or values (nil
+// for fields or values that are not deprecated). Returns false if not all fields or values are deprecated for the same
+// reason.
+func typeDeprecation(elems []*deprecation) (deprecation, bool) {
+	if len(elems) == 0 {
+		return deprecation{}, false
+	}
+	for _, d := range elems {
+		if d == nil || d.typeReason != elems[0].typeReason || d.removalDate != elems[0].removalDate {
+			return deprecation{}, false
+		}
+	}
+	return deprecation{reason: elems[0].typeReason, removalDate: elems[0].removalDate}, true
+}
+
+// deprecationReason returns reason as a sentence.
+func deprecationReason(reason string) string {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		// The default deprecation reason of the GraphQL specification.
+		return "No longer supported."
+	}
+	if !strings.HasSuffix(reason, ".") {
+		reason += "."
 	}
+	return reason
 }
 
 type Type struct {
@@ -233,6 +703,8 @@ type Type struct {
 type InputField struct {
 	DefaultValue any     `json:"defaultValue"`
 	Description  string  `json:"description"`
+	IsDeprecated bool    `json:"isDeprecated"`
+	Deprecated   string  `json:"deprecationReason"`
 	Name         string  `json:"name"`
 	Type         TypeRef `json:"type"`
 }
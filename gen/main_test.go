@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func Test_generate(t *testing.T) {
+	test := func(t *testing.T, schemaPath string) {
+		types, err := readSchemaFile(schemaPath)
+		require.NoError(t, err)
+		genSrc, compatSrc, err := generate(types)
+		require.NoError(t, err)
+		if *update {
+			require.NoError(t, os.WriteFile("testdata/gen.go.golden", genSrc, 0600))
+			require.NoError(t, os.WriteFile("testdata/compat_gen.go.golden", compatSrc, 0600))
+		}
+		wantGenSrc, err := os.ReadFile("testdata/gen.go.golden")
+		require.NoError(t, err)
+		wantCompatSrc, err := os.ReadFile("testdata/compat_gen.go.golden")
+		require.NoError(t, err)
+		assert.Equal(t, string(wantGenSrc), string(genSrc))
+		assert.Equal(t, string(wantCompatSrc), string(compatSrc))
+
+		// Generating again gives the same output.
+		genSrc2, compatSrc2, err := generate(types)
+		require.NoError(t, err)
+		assert.Equal(t, genSrc, genSrc2)
+		assert.Equal(t, compatSrc, compatSrc2)
+	}
+	t.Run("Case1", func(t *testing.T) {
+		test(t, "testdata/schema.json")
+	})
+	t.Run("Case2", func(t *testing.T) {
+		test(t, "testdata/schema.graphql")
+	})
+}
+
+func Test_decodeIntrospection(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		types, err := decodeIntrospection([]byte(`{"__schema":{"types":[{"kind":"ENUM","name":"A"}]}}`), false)
+		require.NoError(t, err)
+		if assert.Len(t, types, 1) {
+			assert.Equal(t, "A", types[0].Name)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		_, err := decodeIntrospection([]byte(`{"data":{"__schema":{"types":[],"unknown":1}}}`), true)
+		assert.Error(t, err)
+	})
+	t.Run("Case3", func(t *testing.T) {
+		_, err := decodeIntrospection([]byte(`{"data":null}`), false)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package githubv4
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// LoaderOptions are options for NewLoader.
+type LoaderOptions struct {
+	// Wait is how long Load calls are collected before they are sent as one batch. Defaults to 2ms.
+	Wait time.Duration
+
+	// MaxBatchSize is the maximum number of IDs of a batch. A batch is sent as soon as it is full. Defaults to 100.
+	MaxBatchSize int
+}
+
+// Loader batches and caches lookups of objects by global ID, in the style of DataLoader
+// (https://github.com/graphql/dataloader).
+// Load calls from many goroutines are collected over a short window, and sent as one nodes(ids:) query using (*Client).Nodes.
+// Flush sends the collected Load calls without waiting for the window to pass.
+// Results are cached for the lifetime of the Loader, so a Loader is typically constructed per incoming request.
+// Errors other than errors wrapping ErrNodeNotFound are not cached.
+//
+// T defines the selection set of an inline fragment. See (*Client).Node.
+type Loader[T any] struct {
+	ctx          context.Context
+	c            *Client
+	wait         time.Duration
+	maxBatchSize int
+
+	mu    sync.Mutex
+	cache map[string]*loaderEntry[T]
+	batch *loaderBatch[T]
+}
+
+type loaderEntry[T any] struct {
+	done  chan struct{}
+	value *T
+	err   error
+}
+
+type loaderBatch[T any] struct {
+	ids     []ID
+	entries []*loaderEntry[T]
+	timer   *time.Timer
+}
+
+// NewLoader constructs a *Loader[T] that fetches objects using c.
+// ctx is used for the queries of all batches, and typically is the context of an incoming request.
+func NewLoader[T any](ctx context.Context, c *Client, opts *LoaderOptions) *Loader[T] {
+	if opts == nil {
+		opts = &LoaderOptions{}
+	}
+	l := &Loader[T]{
+		ctx:          ctx,
+		c:            c,
+		wait:         opts.Wait,
+		maxBatchSize: opts.MaxBatchSize,
+		cache:        map[string]*loaderEntry[T]{},
+	}
+	if l.wait <= 0 {
+		l.wait = 2 * time.Millisecond
+	}
+	if l.maxBatchSize <= 0 || l.maxBatchSize > maxNodeIDs {
+		l.maxBatchSize = maxNodeIDs
+	}
+	return l
+}
+
+// Load returns the object with global ID id. Load blocks until the batch that includes id was sent, or until ctx is done.
+// If id does not resolve to an object of the expected type then returns an error wrapping ErrNodeNotFound.
+func (l *Loader[T]) Load(ctx context.Context, id ID) (*T, error) {
+	l.mu.Lock()
+	entry, ok := l.cache[id.S]
+	if !ok {
+		entry = &loaderEntry[T]{
+			done: make(chan struct{}),
+		}
+		l.cache[id.S] = entry
+		if l.batch == nil {
+			batch := &loaderBatch[T]{}
+			batch.timer = time.AfterFunc(l.wait, func() {
+				l.mu.Lock()
+				if l.batch != batch {
+					// Batch was already dispatched because it was full or flushed.
+					l.mu.Unlock()
+					return
+				}
+				l.batch = nil
+				l.mu.Unlock()
+				l.dispatch(batch)
+			})
+			l.batch = batch
+		}
+		batch := l.batch
+		batch.ids = append(batch.ids, id)
+		batch.entries = append(batch.entries, entry)
+		if len(batch.ids) >= l.maxBatchSize {
+			l.batch = nil
+			batch.timer.Stop()
+			go l.dispatch(batch)
+		}
+	}
+	l.mu.Unlock()
+	select {
+	case <-entry.done:
+		return entry.value, entry.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Flush sends the pending batch (if any) immediately, instead of when Wait has passed or the batch is full. Flush returns
+// after the Load calls of the batch have their results.
+func (l *Loader[T]) Flush() {
+	l.mu.Lock()
+	batch := l.batch
+	l.batch = nil
+	l.mu.Unlock()
+	if batch != nil {
+		batch.timer.Stop()
+		l.dispatch(batch)
+	}
+}
+
+// dispatch sends batch and completes its entries.
+func (l *Loader[T]) dispatch(batch *loaderBatch[T]) {
+	var values []*T
+	_, err := l.c.Nodes(l.ctx, batch.ids, &values)
+	var nodesErr *NodesError
+	isNodesErr := errors.As(err, &nodesErr)
+	l.mu.Lock()
+	for i, entry := range batch.entries {
+		switch {
+		case err == nil:
+			entry.value = values[i]
+		case isNodesErr:
+			entry.value = values[i]
+			entry.err = nodesErr.Errors[i]
+		default:
+			entry.err = err
+		}
+		if entry.err != nil && !errors.Is(entry.err, ErrNodeNotFound) && l.cache[batch.ids[i].S] == entry {
+			delete(l.cache, batch.ids[i].S)
+		}
+		close(entry.done)
+	}
+	l.mu.Unlock()
+}
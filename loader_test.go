@@ -0,0 +1,124 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Loader(t *testing.T) {
+	// newServer returns a client for a server that resolves IDs "I<n>" to issues with title "t<n>", except for "I404".
+	newServer := func(t *testing.T, mu *sync.Mutex, batches *[][]string) *Client {
+		return newTestClient(t, func(req testRequest) (int, string) {
+			var ids []string
+			assert.NoError(t, json.Unmarshal(req.Variables["ids"], &ids))
+			mu.Lock()
+			*batches = append(*batches, ids)
+			mu.Unlock()
+			var nodes []string
+			for _, id := range ids {
+				if id == "I404" {
+					nodes = append(nodes, `null`)
+				} else {
+					nodes = append(nodes, fmt.Sprintf(`{"__typename":"Issue","title":"t%s"}`, id[1:]))
+				}
+			}
+			return http.StatusOK, fmt.Sprintf(`{"data":{"nodes":[%s]}}`, strings.Join(nodes, ","))
+		})
+	}
+	t.Run("Case1", func(t *testing.T) {
+		var mu sync.Mutex
+		var batches [][]string
+		c := newServer(t, &mu, &batches)
+		l := NewLoader[IssueFragment](context.Background(), c, &LoaderOptions{
+			Wait: time.Hour,
+		})
+		var wg sync.WaitGroup
+		titles := make([]string, 10)
+		for i := range titles {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				issue, err := l.Load(context.Background(), ID{S: fmt.Sprintf("I%d", i%5)})
+				if assert.NoError(t, err) {
+					titles[i] = issue.Title
+				}
+			}(i)
+		}
+		// Wait until the five distinct IDs are pending, instead of relying on timing.
+		for pendingIDs(l) < 5 {
+			runtime.Gosched()
+		}
+		l.Flush()
+		wg.Wait()
+		assert.Equal(t, []string{"t0", "t1", "t2", "t3", "t4", "t0", "t1", "t2", "t3", "t4"}, titles)
+		if assert.Len(t, batches, 1) {
+			assert.Len(t, batches[0], 5)
+		}
+		_, err := l.Load(context.Background(), ID{S: "I3"})
+		assert.NoError(t, err)
+		assert.Len(t, batches, 1)
+	})
+	t.Run("Case2", func(t *testing.T) {
+		var mu sync.Mutex
+		var batches [][]string
+		c := newServer(t, &mu, &batches)
+		l := NewLoader[IssueFragment](context.Background(), c, &LoaderOptions{
+			Wait:         time.Hour,
+			MaxBatchSize: 2,
+		})
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i, id := range []string{"I1", "I404"} {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+				_, errs[i] = l.Load(context.Background(), ID{S: id})
+			}(i, id)
+		}
+		wg.Wait()
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], ErrNodeNotFound)
+		assert.Len(t, batches, 1)
+	})
+	t.Run("Case3", func(t *testing.T) {
+		var mu sync.Mutex
+		var batches [][]string
+		c := newServer(t, &mu, &batches)
+		l := NewLoader[IssueFragment](context.Background(), c, &LoaderOptions{
+			Wait: time.Hour,
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := l.Load(ctx, ID{S: "I1"})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+	t.Run("Case4", func(t *testing.T) {
+		var mu sync.Mutex
+		var batches [][]string
+		c := newServer(t, &mu, &batches)
+		l := NewLoader[IssueFragment](context.Background(), c, &LoaderOptions{
+			Wait: time.Hour,
+		})
+		l.Flush()
+		assert.Empty(t, batches)
+	})
+}
+
+// pendingIDs returns the number of IDs of the batch of l that was not sent yet.
+func pendingIDs[T any](l *Loader[T]) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.batch == nil {
+		return 0
+	}
+	return len(l.batch.ids)
+}
@@ -0,0 +1,35 @@
+package githubv4
+
+import "context"
+
+// Metadata is information about an operation that is not part of the response data.
+// See WithMetadata.
+type Metadata struct {
+	// OperationName is the name of the operation, or the empty string if the operation is anonymous.
+	// See OperationNamer and WithOperationName.
+	OperationName string
+
+	// ClientMutationIDs maps names of input variables to the client mutation IDs of the inputs.
+	// Only set for mutations when the *Client was constructed with WithClientMutationIDs.
+	ClientMutationIDs map[string]string
+
+	// RateLimit is the rate limit status reported by the response of a query.
+	// Set for queries when the *Client was constructed with WithRateLimitCapture, and by EstimateCost when it succeeds (to the
+	// estimated cost, see EstimateCost).
+	RateLimit *RateLimit
+}
+
+type metadataContextKey struct{}
+
+// WithMetadata returns a context that makes operations of *Client set *md.
+// If several operations are done with the returned context (for example by Paginate), *md reflects the last operation.
+// md is not safe for concurrent use, so the returned context should not be shared by concurrent operations.
+func WithMetadata(ctx context.Context, md *Metadata) context.Context {
+	return context.WithValue(ctx, metadataContextKey{}, md)
+}
+
+// metadataFromContext returns the *Metadata set by WithMetadata, or nil.
+func metadataFromContext(ctx context.Context) *Metadata {
+	md, _ := ctx.Value(metadataContextKey{}).(*Metadata)
+	return md
+}
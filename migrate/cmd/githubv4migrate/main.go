@@ -0,0 +1,17 @@
+// Command githubv4migrate migrates code from github.com/shurcooL/githubv4 to github.com/jbrekelmans/go-githubv4.
+//
+// Usage:
+//
+//	githubv4migrate [-fix] packages...
+//
+// See package github.com/jbrekelmans/go-githubv4/migrate for the rewrites.
+package main
+
+import (
+	"github.com/jbrekelmans/go-githubv4/migrate"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(migrate.Analyzer)
+}
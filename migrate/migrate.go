@@ -0,0 +1,624 @@
+// Package migrate defines an analyzer that migrates code from github.com/shurcooL/githubv4 (or its compatibility package
+// github.com/jbrekelmans/go-githubv4/compat) to github.com/jbrekelmans/go-githubv4, and code written against versions of
+// github.com/jbrekelmans/go-githubv4 that declared nullable fields of input objects as pointers.
+//
+// The analyzer reports each usage that must change, with a suggested fix where the change is mechanical:
+//   - imports are changed to the native package;
+//   - the error of Query and Mutate is assigned as the second result, e.g. "err := c.Query(...)" becomes
+//     "_, err := c.Query(...)";
+//   - conversions to string scalars become composite literals, e.g. githubv4.HTML(s) becomes githubv4.HTML{S: s};
+//   - Date, GitTimestamp and URI literals are formatted, e.g. githubv4.URI{URL: u} becomes githubv4.URI{S: u.String()};
+//   - primitive conversions in fields of input objects are removed, e.g. Body: githubv4.String(s) becomes Body: s;
+//   - pointers in nullable fields of input objects become Optionals, e.g. Body: githubv4.NewString(s) becomes
+//     Body: githubv4.Some(s), and State: p becomes State: githubv4.FromPtr(p);
+//   - type assertions of IDs become field accesses, e.g. id.(string) becomes id.S;
+//   - pointers assigned to Optional fields of the native package become Optionals, e.g. Title: &title becomes
+//     Title: githubv4.Some(title), Title: githubv4.NewString("t") becomes Title: githubv4.Some("t"), and
+//     input.State = p becomes input.State = githubv4.FromPtr(p).
+//
+// Usages without a suggested fix, like accessing the Time field of a Date, must be changed by hand.
+// Run the analyzer with -fix to apply the suggested fixes, one package at a time.
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer migrates code from github.com/shurcooL/githubv4 to github.com/jbrekelmans/go-githubv4.
+var Analyzer = &analysis.Analyzer{
+	Name:     "githubv4migrate",
+	Doc:      "migrate from github.com/shurcooL/githubv4 to github.com/jbrekelmans/go-githubv4",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+	// Code that assigns pointers to Optional fields of the native package does not type check.
+	RunDespiteErrors: true,
+}
+
+// nativePath is the import path of the native package.
+const nativePath = "github.com/jbrekelmans/go-githubv4"
+
+// legacyPaths are the import paths of packages to migrate from.
+var legacyPaths = map[string]bool{
+	"github.com/shurcooL/githubv4":              true,
+	"github.com/jbrekelmans/go-githubv4/compat": true,
+}
+
+// stringScalars are the scalars that are strings in legacy packages, and structs with a field S in the native package.
+var stringScalars = map[string]bool{
+	"Base64String": true,
+	"GitObjectID":  true,
+	"GitSSHRemote": true,
+	"HTML":         true,
+	"ID":           true,
+}
+
+// formattedScalars are the scalars that embed a field in legacy packages, and are structs with a field S in the native
+// package. Maps type names to the name of the embedded field and the format of the field as a Go expression.
+var formattedScalars = map[string]struct {
+	field  string
+	format string
+}{
+	"Date":         {field: "Time", format: `%s.Format("2006-01-02")`},
+	"GitTimestamp": {field: "Time", format: `%s.Format("2006-01-02T15:04:05Z07:00")`},
+	"URI":          {field: "URL", format: `%s.String()`},
+}
+
+// primitives maps the primitive scalars of legacy packages to the Go types of fields of native input objects.
+var primitives = map[string]string{
+	"Boolean": "bool",
+	"Float":   "float64",
+	"Int":     "int",
+	"String":  "string",
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.ImportSpec)(nil),
+		(*ast.AssignStmt)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.CompositeLit)(nil),
+		(*ast.SelectorExpr)(nil),
+		(*ast.TypeAssertExpr)(nil),
+	}
+	ins.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		switch n := n.(type) {
+		case *ast.ImportSpec:
+			checkImport(pass, n)
+		case *ast.AssignStmt:
+			checkAssign(pass, n, stack[0].(*ast.File))
+		case *ast.CallExpr:
+			checkCall(pass, n, stack)
+		case *ast.CompositeLit:
+			checkCompositeLit(pass, n, stack[0].(*ast.File))
+		case *ast.SelectorExpr:
+			checkSelector(pass, n)
+		case *ast.TypeAssertExpr:
+			checkTypeAssert(pass, n)
+		}
+		return true
+	})
+	return nil, nil
+}
+
+func checkImport(pass *analysis.Pass, spec *ast.ImportSpec) {
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil || !legacyPaths[path] {
+		return
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:     spec.Pos(),
+		End:     spec.End(),
+		Message: fmt.Sprintf("import %s instead of %s", nativePath, path),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   "Import " + nativePath,
+			TextEdits: []analysis.TextEdit{replace(spec.Path, strconv.Quote(nativePath))},
+		}},
+	})
+}
+
+func checkCall(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	if name, ok := legacyType(pass, call.Fun); ok {
+		if len(call.Args) == 1 {
+			checkConversion(pass, call, name, stack)
+		}
+		return
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Query" && sel.Sel.Name != "Mutate") {
+		return
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || !legacyPaths[fn.Pkg().Path()] {
+		return
+	}
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil || !isNamed(recv.Type(), "Client") {
+		return
+	}
+	// The native Query and Mutate return (*http.Response, error) instead of error.
+	parent := stack[len(stack)-2]
+	switch parent := parent.(type) {
+	case *ast.ExprStmt:
+		// The results are discarded, which is fine for any number of results.
+		return
+	case *ast.AssignStmt:
+		if len(parent.Lhs) == 1 && len(parent.Rhs) == 1 {
+			pass.Report(analysis.Diagnostic{
+				Pos:     call.Pos(),
+				End:     call.End(),
+				Message: fmt.Sprintf("%s returns (*http.Response, error)", sel.Sel.Name),
+				SuggestedFixes: []analysis.SuggestedFix{{
+					Message: "Ignore the *http.Response",
+					TextEdits: []analysis.TextEdit{{
+						Pos:     parent.Lhs[0].Pos(),
+						End:     parent.Lhs[0].Pos(),
+						NewText: []byte("_, "),
+					}},
+				}},
+			})
+			return
+		}
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: fmt.Sprintf("%s returns (*http.Response, error), rewrite by hand", sel.Sel.Name),
+	})
+}
+
+// checkConversion checks conversion call to the scalar of a legacy package named name.
+func checkConversion(pass *analysis.Pass, call *ast.CallExpr, name string, stack []ast.Node) {
+	arg := call.Args[0]
+	switch {
+	case stringScalars[name]:
+		tv := pass.TypesInfo.Types[arg]
+		var basic *types.Basic
+		if tv.Type != nil {
+			basic, _ = tv.Type.Underlying().(*types.Basic)
+		}
+		if basic == nil || basic.Info()&types.IsString == 0 {
+			pass.Report(analysis.Diagnostic{
+				Pos:     call.Pos(),
+				End:     call.End(),
+				Message: fmt.Sprintf("%s is a struct with field S of type string, rewrite by hand", name),
+			})
+			return
+		}
+		value := render(pass, arg)
+		if _, isLit := arg.(*ast.BasicLit); !isLit && !types.Identical(tv.Type, types.Typ[types.String]) &&
+			!isUntyped(tv.Type) {
+			value = "string(" + value + ")"
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:     call.Pos(),
+			End:     call.End(),
+			Message: fmt.Sprintf("%s is a struct with field S", name),
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   "Use a composite literal",
+				TextEdits: []analysis.TextEdit{replace(call, fmt.Sprintf("%s{S: %s}", render(pass, call.Fun), value))},
+			}},
+		})
+	case primitives[name] != "" && isInputFieldValue(pass, stack):
+		value := render(pass, arg)
+		argType := pass.TypesInfo.TypeOf(arg)
+		if !isUntyped(argType) && !types.Identical(argType, types.Universe.Lookup(primitives[name]).Type()) {
+			value = primitives[name] + "(" + value + ")"
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:     call.Pos(),
+			End:     call.End(),
+			Message: fmt.Sprintf("fields of input objects have type %s instead of %s", primitives[name], name),
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   "Remove the conversion",
+				TextEdits: []analysis.TextEdit{replace(call, value)},
+			}},
+		})
+	}
+}
+
+// isInputFieldValue returns true if the top of stack is the value of a field in a composite literal of an input object of
+// a legacy package.
+func isInputFieldValue(pass *analysis.Pass, stack []ast.Node) bool {
+	if len(stack) < 3 {
+		return false
+	}
+	kv, ok := stack[len(stack)-2].(*ast.KeyValueExpr)
+	if !ok || kv.Value != stack[len(stack)-1] {
+		return false
+	}
+	lit, ok := stack[len(stack)-3].(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+	name, ok := legacyType(pass, lit.Type)
+	return ok && strings.HasSuffix(name, "Input")
+}
+
+func checkCompositeLit(pass *analysis.Pass, lit *ast.CompositeLit, file *ast.File) {
+	name, ok := legacyType(pass, lit.Type)
+	if !ok {
+		checkNativeLit(pass, lit, file)
+		return
+	}
+	if strings.HasSuffix(name, "Input") {
+		checkInputLit(pass, lit)
+		return
+	}
+	if len(lit.Elts) != 1 {
+		return
+	}
+	scalar, ok := formattedScalars[name]
+	if !ok {
+		return
+	}
+	value := lit.Elts[0]
+	if kv, ok := value.(*ast.KeyValueExpr); ok {
+		value = kv.Value
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: fmt.Sprintf("%s is a struct with field S instead of an embedded %s", name, scalar.field),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "Format the " + scalar.field,
+			TextEdits: []analysis.TextEdit{replace(lit, fmt.Sprintf("%s{S: %s}", render(pass, lit.Type),
+				fmt.Sprintf(scalar.format, render(pass, value))))},
+		}},
+	})
+}
+
+// checkInputLit checks composite literal lit of an input object of a legacy package. Nullable fields are pointers in legacy
+// packages, and Optionals in the native package.
+func checkInputLit(pass *analysis.Pass, lit *ast.CompositeLit) {
+	st, ok := pass.TypesInfo.TypeOf(lit).Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	pkg := render(pass, lit.Type.(*ast.SelectorExpr).X)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		var ptr *types.Pointer
+		for i := 0; i < st.NumFields(); i++ {
+			if f := st.Field(i); f.Name() == key.Name {
+				ptr, _ = f.Type().(*types.Pointer)
+			}
+		}
+		if ptr == nil {
+			continue
+		}
+		elemName, _ := legacyNamed(ptr.Elem())
+		var fix string
+		switch {
+		case isNewPrimitive(pass, kv.Value):
+			arg := kv.Value.(*ast.CallExpr).Args[0]
+			if conv, ok := arg.(*ast.CallExpr); ok && len(conv.Args) == 1 {
+				// Unwrap a conversion to the primitive, e.g. githubv4.NewString(githubv4.String(s)).
+				if convName, ok := legacyType(pass, conv.Fun); ok && convName == elemName {
+					arg = conv.Args[0]
+				}
+			}
+			value := render(pass, arg)
+			argType := pass.TypesInfo.TypeOf(arg)
+			if !isUntyped(argType) && !types.Identical(argType, types.Universe.Lookup(primitives[elemName]).Type()) {
+				value = primitives[elemName] + "(" + value + ")"
+			}
+			fix = pkg + ".Some(" + value + ")"
+		case primitives[elemName] != "" || isNil(pass, kv.Value):
+			// The native field is an Optional of a Go type (e.g. Optional[string] instead of *String), or the field is set to
+			// nil, which is an unset Optional.
+		default:
+			fix = pkg + ".FromPtr(" + render(pass, kv.Value) + ")"
+		}
+		d := analysis.Diagnostic{
+			Pos:     kv.Value.Pos(),
+			End:     kv.Value.End(),
+			Message: fmt.Sprintf("nullable field %s is an Optional instead of a pointer, rewrite by hand", key.Name),
+		}
+		if fix != "" {
+			d.Message = fmt.Sprintf("nullable field %s is an Optional instead of a pointer", key.Name)
+			d.SuggestedFixes = []analysis.SuggestedFix{{
+				Message:   "Use an Optional",
+				TextEdits: []analysis.TextEdit{replace(kv.Value, fix)},
+			}}
+		}
+		pass.Report(d)
+	}
+}
+
+// checkNativeLit checks composite literal lit of a struct of the native package.
+func checkNativeLit(pass *analysis.Pass, lit *ast.CompositeLit, file *ast.File) {
+	t := pass.TypesInfo.TypeOf(lit)
+	if t == nil {
+		return
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		for i := 0; i < st.NumFields(); i++ {
+			if f := st.Field(i); f.Name() == key.Name {
+				checkOptional(pass, f, kv.Value, file)
+			}
+		}
+	}
+}
+
+// checkAssign checks assignments to fields of structs of the native package.
+func checkAssign(pass *analysis.Pass, assign *ast.AssignStmt, file *ast.File) {
+	if assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+	for i, lhs := range assign.Lhs {
+		sel, ok := lhs.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+		if selection := pass.TypesInfo.Selections[sel]; selection != nil && selection.Kind() == types.FieldVal {
+			checkOptional(pass, selection.Obj().(*types.Var), assign.Rhs[i], file)
+		}
+	}
+}
+
+// checkOptional checks value assigned to field f. Earlier versions of the native package declared nullable fields of input
+// objects as pointers, which are now Optionals.
+func checkOptional(pass *analysis.Pass, f *types.Var, value ast.Expr, file *ast.File) {
+	elem, ok := optionalElem(f.Type())
+	if !ok {
+		return
+	}
+	valueType := pass.TypesInfo.TypeOf(value)
+	ptr, ok := valueType.(*types.Pointer)
+	if !ok && !isNil(pass, value) {
+		return
+	}
+	pkg := importName(file, nativePath)
+	var fix string
+	switch {
+	case ptr == nil:
+		// A nil pointer is an unset Optional, rewrite by hand.
+	case isAddr(value) && types.Identical(pass.TypesInfo.TypeOf(value.(*ast.UnaryExpr).X), elem):
+		fix = pkg + ".Some(" + render(pass, value.(*ast.UnaryExpr).X) + ")"
+	case isNativeNew(pass, value):
+		arg := value.(*ast.CallExpr).Args[0]
+		switch lit, isLit := arg.(*ast.BasicLit); {
+		case isLit && types.Identical(defaultType(lit), elem):
+			fix = pkg + ".Some(" + render(pass, arg) + ")"
+		case types.Identical(pass.TypesInfo.TypeOf(arg), elem):
+			fix = pkg + ".Some(" + render(pass, arg) + ")"
+		default:
+			if basic, ok := elem.(*types.Basic); ok {
+				fix = pkg + ".Some(" + basic.Name() + "(" + render(pass, arg) + "))"
+			}
+		}
+	case types.Identical(ptr.Elem(), elem):
+		fix = pkg + ".FromPtr(" + render(pass, value) + ")"
+	}
+	d := analysis.Diagnostic{
+		Pos:     value.Pos(),
+		End:     value.End(),
+		Message: fmt.Sprintf("nullable field %s is an Optional instead of a pointer, rewrite by hand", f.Name()),
+	}
+	if fix != "" {
+		d.Message = fmt.Sprintf("nullable field %s is an Optional instead of a pointer", f.Name())
+		d.SuggestedFixes = []analysis.SuggestedFix{{
+			Message:   "Use an Optional",
+			TextEdits: []analysis.TextEdit{replace(value, fix)},
+		}}
+	}
+	pass.Report(d)
+}
+
+// optionalElem returns T, if t is Optional[T] of the native package.
+func optionalElem(t types.Type) (types.Type, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.TypeArgs().Len() != 1 {
+		return nil, false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != nativePath || obj.Name() != "Optional" {
+		return nil, false
+	}
+	return named.TypeArgs().At(0), true
+}
+
+// isAddr returns true if x takes the address of an operand, e.g. &title.
+func isAddr(x ast.Expr) bool {
+	unary, ok := x.(*ast.UnaryExpr)
+	return ok && unary.Op == token.AND
+}
+
+// isNativeNew returns true if x calls a pointer constructor of the native package, e.g. githubv4.NewString.
+func isNativeNew(pass *analysis.Pass, x ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == nativePath && strings.HasPrefix(fn.Name(), "New")
+}
+
+// defaultType returns the type of an untyped constant lit in a context without an explicit type, e.g. string for "t".
+func defaultType(lit *ast.BasicLit) types.Type {
+	switch lit.Kind {
+	case token.INT:
+		return types.Typ[types.Int]
+	case token.FLOAT:
+		return types.Typ[types.Float64]
+	case token.STRING:
+		return types.Typ[types.String]
+	}
+	return types.Typ[types.Invalid]
+}
+
+// importName returns the name by which file refers to the package with import path path.
+func importName(file *ast.File, path string) string {
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err == nil && p == path && spec.Name != nil {
+			return spec.Name.Name
+		}
+	}
+	return "githubv4"
+}
+
+// isNewPrimitive returns true if x calls a constructor of a primitive scalar of a legacy package, e.g. githubv4.NewString.
+func isNewPrimitive(pass *analysis.Pass, x ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || !legacyPaths[fn.Pkg().Path()] {
+		return false
+	}
+	name, ok := strings.CutPrefix(fn.Name(), "New")
+	return ok && primitives[name] != ""
+}
+
+// isNil returns true if x is the predeclared nil.
+func isNil(pass *analysis.Pass, x ast.Expr) bool {
+	return pass.TypesInfo.Types[x].IsNil()
+}
+
+func checkSelector(pass *analysis.Pass, sel *ast.SelectorExpr) {
+	tv, ok := pass.TypesInfo.Types[sel.X]
+	if !ok || tv.IsType() {
+		return
+	}
+	name, ok := legacyNamed(tv.Type)
+	if !ok {
+		return
+	}
+	if scalar, ok := formattedScalars[name]; ok && pass.TypesInfo.Selections[sel] != nil {
+		pass.Report(analysis.Diagnostic{
+			Pos: sel.Pos(),
+			End: sel.End(),
+			Message: fmt.Sprintf("%s is a struct with field S instead of an embedded %s, parse S by hand", name,
+				scalar.field),
+		})
+	}
+}
+
+func checkTypeAssert(pass *analysis.Pass, assert *ast.TypeAssertExpr) {
+	tv, ok := pass.TypesInfo.Types[assert.X]
+	if !ok || assert.Type == nil {
+		return
+	}
+	if name, ok := legacyNamed(tv.Type); !ok || name != "ID" {
+		return
+	}
+	if !types.Identical(pass.TypesInfo.TypeOf(assert.Type), types.Typ[types.String]) {
+		pass.Report(analysis.Diagnostic{
+			Pos:     assert.Pos(),
+			End:     assert.End(),
+			Message: "ID is a struct with field S of type string, rewrite by hand",
+		})
+		return
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:     assert.Pos(),
+		End:     assert.End(),
+		Message: "ID is a struct with field S",
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   "Use field S",
+			TextEdits: []analysis.TextEdit{replace(assert, render(pass, assert.X)+".S")},
+		}},
+	})
+}
+
+// legacyType returns the name of the type denoted by expression x, if x denotes a named type of a legacy package.
+func legacyType(pass *analysis.Pass, x ast.Expr) (string, bool) {
+	sel, ok := x.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	obj, ok := pass.TypesInfo.Uses[sel.Sel].(*types.TypeName)
+	if !ok || obj.Pkg() == nil || !legacyPaths[obj.Pkg().Path()] {
+		return "", false
+	}
+	return obj.Name(), true
+}
+
+// legacyNamed returns the name of t, if t is a named type of a legacy package.
+func legacyNamed(t types.Type) (string, bool) {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return "", false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || !legacyPaths[obj.Pkg().Path()] {
+		return "", false
+	}
+	return obj.Name(), true
+}
+
+// isUntyped returns true if t is the type of an untyped constant.
+func isUntyped(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	return ok && basic.Info()&types.IsUntyped != 0
+}
+
+// isNamed returns true if t is (a pointer to) a named type with name name.
+func isNamed(t types.Type, name string) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Name() == name
+}
+
+// render returns the source code of node.
+func render(pass *analysis.Pass, node ast.Node) string {
+	var b bytes.Buffer
+	if err := format.Node(&b, pass.Fset, node); err != nil {
+		panic(err)
+	}
+	return b.String()
+}
+
+// replace returns a text edit that replaces node by newText.
+func replace(node ast.Node, newText string) analysis.TextEdit {
+	return analysis.TextEdit{
+		Pos:     node.Pos(),
+		End:     node.End(),
+		NewText: []byte(newText),
+	}
+}
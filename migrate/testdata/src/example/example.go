@@ -0,0 +1,49 @@
+package example
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/shurcooL/githubv4" // want `import github.com/jbrekelmans/go-githubv4 instead of github.com/shurcooL/githubv4`
+)
+
+func query(ctx context.Context, c *githubv4.Client, id string, body string, u *url.URL, t time.Time) error {
+	var q struct {
+		Node struct {
+			ID githubv4.ID
+		}
+	}
+	c.Query(ctx, &q, nil)
+	err := c.Query(ctx, &q, map[string]any{ // want `Query returns \(\*http.Response, error\)`
+		"id":   githubv4.ID(id),                  // want `ID is a struct with field S`
+		"html": githubv4.HTML("<p>"),             // want `HTML is a struct with field S`
+		"uri":  githubv4.URI{URL: u},             // want `URI is a struct with field S instead of an embedded URL`
+		"date": githubv4.Date{t},                 // want `Date is a struct with field S instead of an embedded Time`
+		"n":    githubv4.ID(1),                   // want `ID is a struct with field S of type string, rewrite by hand`
+		"ts":   githubv4.GitTimestamp{Time: t},   // want `GitTimestamp is a struct with field S instead of an embedded Time`
+		"s":    githubv4.String("kept as is"),
+	})
+	if err != nil {
+		return err
+	}
+	_ = q.Node.ID.(string) // want `ID is a struct with field S`
+	var d githubv4.Date
+	_ = d.Time // want `Date is a struct with field S instead of an embedded Time, parse S by hand`
+	input := githubv4.AddCommentInput{
+		SubjectID: githubv4.ID(id),       // want `ID is a struct with field S`
+		Body:      githubv4.String(body), // want `fields of input objects have type string instead of String`
+	}
+	return c.Mutate(ctx, nil, input, nil) // want `Mutate returns \(\*http.Response, error\), rewrite by hand`
+}
+
+func update(ctx context.Context, c *githubv4.Client, title string, state *githubv4.IssueState, body *githubv4.String) error {
+	input := githubv4.UpdateIssueInput{
+		Title:       githubv4.NewString(githubv4.String(title)), // want `nullable field Title is an Optional instead of a pointer`
+		State:       state,                                      // want `nullable field State is an Optional instead of a pointer`
+		Body:        body,                                       // want `nullable field Body is an Optional instead of a pointer, rewrite by hand`
+		MilestoneID: nil,                                        // want `nullable field MilestoneID is an Optional instead of a pointer, rewrite by hand`
+	}
+	c.Mutate(ctx, nil, input, nil)
+	return nil
+}
@@ -0,0 +1,41 @@
+// Package githubv4 is a stub of github.com/jbrekelmans/go-githubv4.
+package githubv4
+
+type Optional[T any] struct {
+	value T
+	set   bool
+}
+
+func Some[T any](v T) Optional[T] { return Optional[T]{value: v, set: true} }
+
+func FromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return Optional[T]{}
+	}
+	return Some(*p)
+}
+
+type (
+	ID     struct{ S string }
+	Int    int32
+	String string
+)
+
+type IssueState string
+
+type UpdateIssueInput struct {
+	ID          ID                   `json:"id"`
+	Title       Optional[string]     `json:"title"`
+	Body        Optional[string]     `json:"body"`
+	State       Optional[IssueState] `json:"state"`
+	MilestoneID Optional[ID]         `json:"milestoneId"`
+}
+
+type AddCommentInput struct {
+	SubjectID ID     `json:"subjectId"`
+	Body      string `json:"body"`
+}
+
+func NewID(v ID) *ID { return &v }
+
+func NewString(v String) *String { return &v }
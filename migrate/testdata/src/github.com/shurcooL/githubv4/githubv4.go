@@ -0,0 +1,46 @@
+// Package githubv4 is a stub of github.com/shurcooL/githubv4.
+package githubv4
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+type Client struct{}
+
+func (c *Client) Query(ctx context.Context, q any, variables map[string]any) error { return nil }
+
+func (c *Client) Mutate(ctx context.Context, m any, input Input, variables map[string]any) error { return nil }
+
+type Input any
+
+type (
+	Boolean      bool
+	Date         struct{ time.Time }
+	GitTimestamp struct{ time.Time }
+	HTML         string
+	ID           any
+	Int          int32
+	String       string
+	URI          struct{ *url.URL }
+)
+
+type AddCommentInput struct {
+	SubjectID        ID      `json:"subjectId"`
+	Body             String  `json:"body"`
+	ClientMutationID *String `json:"clientMutationId,omitempty"`
+}
+
+type IssueState string
+
+type UpdateIssueInput struct {
+	ID               ID          `json:"id"`
+	Title            *String     `json:"title,omitempty"`
+	Body             *String     `json:"body,omitempty"`
+	State            *IssueState `json:"state,omitempty"`
+	MilestoneID      *ID         `json:"milestoneId,omitempty"`
+	ClientMutationID *String     `json:"clientMutationId,omitempty"`
+}
+
+func NewString(v String) *String { return &v }
@@ -0,0 +1,20 @@
+package native
+
+import (
+	gh "github.com/jbrekelmans/go-githubv4"
+)
+
+func update(title string, body gh.String, state *gh.IssueState, milestoneID gh.ID, other *gh.String) {
+	input := gh.UpdateIssueInput{
+		Title:       &title,                    // want `nullable field Title is an Optional instead of a pointer`
+		Body:        gh.NewString(body),        // want `nullable field Body is an Optional instead of a pointer`
+		State:       state,                     // want `nullable field State is an Optional instead of a pointer`
+		MilestoneID: gh.NewID(milestoneID),     // want `nullable field MilestoneID is an Optional instead of a pointer`
+	}
+	input.Title = gh.NewString("t") // want `nullable field Title is an Optional instead of a pointer`
+	input.Body = other              // want `nullable field Body is an Optional instead of a pointer, rewrite by hand`
+	input.State = nil               // want `nullable field State is an Optional instead of a pointer, rewrite by hand`
+	input.Title = gh.Some(title)
+	_ = gh.AddCommentInput{Body: title}
+	_ = input
+}
@@ -0,0 +1,165 @@
+package githubv4
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jbrekelmans/go-graphql/mapping"
+)
+
+// randomClientMutationID generates a random 128-bit hexadecimal client mutation ID.
+func randomClientMutationID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf(`error generating client mutation ID: %w`, err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// setClientMutationIDs returns a copy of inputs where each input that has a nil (or unset Optional) ClientMutationID field is
+// replaced by a copy with a generated client mutation ID. An explicitly null ClientMutationID is left as is.
+// Returns the client mutation IDs of the inputs by variable name. Inputs are never modified.
+// Returns an error if generate returns an error.
+func setClientMutationIDs(inputs Inputs, generate func() (string, error)) (Inputs, map[string]string, error) {
+	ids := map[string]string{}
+	result := make(Inputs, len(inputs))
+	for varName, input := range inputs {
+		result[varName] = input
+		v := reflect.ValueOf(input)
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				continue
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		f := v.FieldByName("ClientMutationID")
+		if !f.IsValid() {
+			continue
+		}
+		var idValue func(id string) reflect.Value
+		switch f.Type() {
+		case reflect.TypeOf((*string)(nil)):
+			if !f.IsNil() {
+				ids[varName] = f.Elem().String()
+				continue
+			}
+			idValue = func(id string) reflect.Value { return reflect.ValueOf(&id) }
+		case reflect.TypeOf(Optional[string]{}):
+			o := f.Interface().(Optional[string])
+			if o.IsSet() {
+				if id, ok := o.Value(); ok {
+					ids[varName] = id
+				}
+				continue
+			}
+			idValue = func(id string) reflect.Value { return reflect.ValueOf(Some(id)) }
+		default:
+			continue
+		}
+		id, err := generate()
+		if err != nil {
+			return nil, nil, err
+		}
+		copied := reflect.New(v.Type())
+		copied.Elem().Set(v)
+		copied.Elem().FieldByName("ClientMutationID").Set(idValue(id))
+		if reflect.TypeOf(input).Kind() == reflect.Ptr {
+			result[varName] = copied.Interface().(Input)
+		} else {
+			result[varName] = copied.Elem().Interface().(Input)
+		}
+		ids[varName] = id
+	}
+	return result, ids, nil
+}
+
+// verifyClientMutationIDs verifies that payloads selected by mutation struct m echo the client mutation IDs in ids.
+// A root field of m references input variable varName if its GraphQL snippet references $varName. Payloads that do not
+// select the clientMutationId field are not verified.
+func verifyClientMutationIDs(m any, ids map[string]string) error {
+	v := reflect.ValueOf(m)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		x := mapping.NewFieldInfo(f)
+		for varName, id := range ids {
+			if !referencesVariable(x.GraphQL(), varName) {
+				continue
+			}
+			echoed, ok := echoedClientMutationID(v.Field(i))
+			if ok && echoed != id {
+				return fmt.Errorf(`payload of %s echoed clientMutationId %#v, expected %#v`, x.FieldName(), echoed, id)
+			}
+		}
+	}
+	return nil
+}
+
+// referencesVariable returns true if the GraphQL snippet graphQL references variable varName.
+func referencesVariable(graphQL string, varName string) bool {
+	ref := "$" + varName
+	for {
+		i := strings.Index(graphQL, ref)
+		if i < 0 {
+			return false
+		}
+		graphQL = graphQL[i+len(ref):]
+		if graphQL == "" || !isNameChar(graphQL[0]) {
+			return true
+		}
+	}
+}
+
+func isNameChar(ch byte) bool {
+	return ch == '_' || ('0' <= ch && ch <= '9') || ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z')
+}
+
+// echoedClientMutationID returns the value of the field of payload that selects clientMutationId.
+// Returns false if payload does not select clientMutationId.
+func echoedClientMutationID(payload reflect.Value) (string, bool) {
+	for payload.Kind() == reflect.Ptr {
+		if payload.IsNil() {
+			return "", false
+		}
+		payload = payload.Elem()
+	}
+	if payload.Kind() != reflect.Struct {
+		return "", false
+	}
+	t := payload.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || !strings.EqualFold(mapping.NewFieldInfo(f).FieldName(), "clientMutationId") {
+			continue
+		}
+		fv := payload.Field(i)
+		switch {
+		case fv.Kind() == reflect.String:
+			return fv.String(), true
+		case fv.Kind() == reflect.Ptr && fv.Type().Elem().Kind() == reflect.String:
+			if fv.IsNil() {
+				return "", true
+			}
+			return fv.Elem().String(), true
+		}
+	}
+	return "", false
+}
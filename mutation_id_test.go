@@ -0,0 +1,150 @@
+package githubv4
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_setClientMutationIDs(t *testing.T) {
+	generate := func() (string, error) {
+		return "gen", nil
+	}
+	t.Run("Case1", func(t *testing.T) {
+		input := AddStarInput{StarrableID: ID{S: "id1"}}
+		actual, ids, err := setClientMutationIDs(Inputs{"input": input}, generate)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"input": "gen"}, ids)
+		assert.False(t, input.ClientMutationID.IsSet())
+		if assert.IsType(t, AddStarInput{}, actual["input"]) {
+			assert.Equal(t, Some("gen"), actual["input"].(AddStarInput).ClientMutationID)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		input := &AddStarInput{StarrableID: ID{S: "id1"}}
+		actual, ids, err := setClientMutationIDs(Inputs{"input": input}, generate)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"input": "gen"}, ids)
+		assert.False(t, input.ClientMutationID.IsSet())
+		if assert.IsType(t, &AddStarInput{}, actual["input"]) {
+			assert.Equal(t, Some("gen"), actual["input"].(*AddStarInput).ClientMutationID)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		input := AddStarInput{ClientMutationID: Some("mine")}
+		actual, ids, err := setClientMutationIDs(Inputs{"input": input}, generate)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"input": "mine"}, ids)
+		assert.Equal(t, input, actual["input"])
+	})
+	t.Run("Case4", func(t *testing.T) {
+		input := AddStarInput{ClientMutationID: Null[string]()}
+		actual, ids, err := setClientMutationIDs(Inputs{"input": input}, generate)
+		assert.NoError(t, err)
+		assert.Empty(t, ids)
+		assert.Equal(t, input, actual["input"])
+	})
+	t.Run("Case5", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		_, _, err := setClientMutationIDs(Inputs{"input": AddStarInput{}}, func() (string, error) {
+			return "", errBoom
+		})
+		assert.Same(t, errBoom, err)
+	})
+}
+
+func Test_referencesVariable(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		assert.True(t, referencesVariable("addStar(input: $input)", "input"))
+	})
+	t.Run("Case2", func(t *testing.T) {
+		assert.False(t, referencesVariable("addStar(input: $input2)", "input"))
+	})
+	t.Run("Case3", func(t *testing.T) {
+		assert.True(t, referencesVariable("addStar(input: $input2, x: $input)", "input"))
+	})
+}
+
+func Test_Client_Mutate_ClientMutationIDs(t *testing.T) {
+	type addStar struct {
+		AddStar struct {
+			ClientMutationID *string
+		} `graphql:"addStar(input: $input)"`
+	}
+	generate := func() string {
+		return "cmid1"
+	}
+	t.Run("Case1", func(t *testing.T) {
+		var logs bytes.Buffer
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			var input AddStarInput
+			assert.NoError(t, json.Unmarshal(req.Variables["input"], &input))
+			assert.Equal(t, Some("cmid1"), input.ClientMutationID)
+			return http.StatusOK, `{"data":{"addStar":{"clientMutationId":"cmid1"}}}`
+		}, WithClientMutationIDs(generate), WithLogger(log.New(&logs, "", 0)))
+		var md Metadata
+		var m addStar
+		_, err := c.Mutate(WithMetadata(context.Background(), &md), &m, AddStarInput{}, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"input": "cmid1"}, md.ClientMutationIDs)
+			assert.Equal(t, "githubv4: mutation with clientMutationIds map[input:cmid1]: ok\n", logs.String())
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"addStar":{"clientMutationId":"other"}}}`
+		}, WithClientMutationIDs(generate))
+		var m addStar
+		_, err := c.Mutate(context.Background(), &m, AddStarInput{}, nil)
+		if assert.ErrorContains(t, err, `echoed clientMutationId "other", expected "cmid1"`) &&
+			assert.IsType(t, &Error{}, err) {
+			assert.Equal(t, map[string]string{"input": "cmid1"}, err.(*Error).ClientMutationIDs)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":null,"errors":[{"message":"m"}]}`
+		}, WithClientMutationIDs(generate))
+		var m addStar
+		_, err := c.Mutate(context.Background(), &m, AddStarInput{}, nil)
+		if assert.IsType(t, &Error{}, err) {
+			assert.Equal(t, map[string]string{"input": "cmid1"}, err.(*Error).ClientMutationIDs)
+		}
+	})
+	t.Run("Case4", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			t.Error("unexpected request")
+			return http.StatusInternalServerError, ``
+		}, func(c *Client) {
+			c.generateClientMutationID = func() (string, error) {
+				return "", errBoom
+			}
+		})
+		var m addStar
+		_, err := c.Mutate(context.Background(), &m, AddStarInput{}, nil)
+		assert.ErrorIs(t, err, errBoom)
+		results, err := c.BulkMutate(context.Background(), &m, []Input{AddStarInput{}}, nil, nil)
+		assert.ErrorIs(t, err, errBoom)
+		if assert.Len(t, results, 1) {
+			assert.ErrorIs(t, results[0].Err, errBoom)
+		}
+	})
+	t.Run("Case5", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"b0_addStar":{"clientMutationId":"other"}}}`
+		}, WithClientMutationIDs(generate))
+		var m addStar
+		_, err := c.BulkMutate(WithOperationName(context.Background(), "StarAll"), &m, []Input{AddStarInput{}}, nil, nil)
+		if assert.ErrorContains(t, err, `echoed clientMutationId "other", expected "cmid1"`) &&
+			assert.IsType(t, &Error{}, err) {
+			assert.Equal(t, "StarAll", err.(*Error).OperationName)
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jbrekelmans/go-graphql"
+	internalJSON "github.com/jbrekelmans/go-graphql/json"
+)
+
+// maxNodeIDs is the maximum number of IDs that GitHub accepts for the ids argument of the nodes field.
+const maxNodeIDs = 100
+
+// ErrNodeNotFound is wrapped by errors of (*Client).Node and (*Client).Nodes for IDs that do not exist (GitHub reports a
+// response error of type NOT_FOUND), or that do not resolve to an object of the expected type.
+var ErrNodeNotFound = errors.New("node not found")
+
+// NodesError is returned by (*Client).Nodes if any of the IDs could not be fetched, including if none of them could.
+type NodesError struct {
+	// Errors has the same length as the IDs passed to (*Client).Nodes. Errors[i] is the error of the i-th ID, or nil if the
+	// object with the i-th ID was fetched successfully.
+	Errors []error
+}
+
+var _ error = (*NodesError)(nil)
+
+// Error implements the error interface.
+func (e *NodesError) Error() string {
+	n := 0
+	var first error
+	for _, err := range e.Errors {
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			n++
+		}
+	}
+	return fmt.Sprintf(`%d of %d nodes could not be fetched, first error: %v`, n, len(e.Errors), first)
+}
+
+// Node fetches the object with global ID id using the node field.
+// fragment is a pointer to a struct that defines the selection set of an inline fragment, and also receives the response data.
+// The type condition of the inline fragment is the type name of the struct, without suffix "Fragment". For example, a struct
+// type named Issue or IssueFragment is selected with "... on Issue".
+//
+// If id does not resolve to an object of that type then returns an error wrapping ErrNodeNotFound.
+// See Query for more information on errors and responses.
+func (c *Client) Node(ctx context.Context, id ID, fragment any) (*http.Response, error) {
+	t := reflect.TypeOf(fragment)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf(`error in (*Client).Node: fragment has non-pointer-to-struct type %T`, fragment)
+	}
+	typeName, err := fragmentTypeName(t.Elem())
+	if err != nil {
+		return nil, err
+	}
+	name, err := operationName(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	variables := map[string]any{
+		"id": id,
+	}
+	var qb queryBuilder
+	qb.operationType("query", name)
+	qb.varDefs(variables)
+	qb.raw("{node(id: $id){__typename")
+	qb.commaFlag = true
+	qb.field("... on "+typeName, t.Elem())
+	qb.raw("}}")
+	operation := qb.String()
+	var data struct {
+		Node map[string]json.RawMessage `json:"node"`
+	}
+	resp, errItems, err := c.send(ctx, request{
+		Query:         operation,
+		Variables:     variables,
+		OperationName: name,
+		isQuery:       true,
+	}, func(b []byte) error {
+		return json.Unmarshal(b, &data)
+	})
+	if err != nil {
+		nodeErr := err
+		if len(errItems) == 1 && resp.StatusCode == http.StatusOK && isNotFound(errItems[0], "node") {
+			nodeErr = fmt.Errorf(`node %#v: %s: %w`, id.S, errItems[0].Message, ErrNodeNotFound)
+		}
+		return resp, setOperationName(enhanceError(&graphql.Error{
+			Err:       nodeErr,
+			Errors:    errItems,
+			Message:   nodeErr.Error(),
+			Operation: operation,
+		}), name)
+	}
+	return resp, decodeNode(data.Node, id, typeName, fragment)
+}
+
+// Nodes fetches the objects with global IDs ids using the nodes field.
+// slice is a pointer to a slice of pointers to structs, where each struct defines the selection set of an inline fragment
+// (see Node). *slice is set to a slice of the same length as ids, where the i-th element receives the response data of the
+// object with the i-th ID.
+// ids are split into requests of at most 100 IDs, which is the limit of the nodes field.
+//
+// If some (or all) IDs could not be fetched then the corresponding elements of *slice are nil and a *NodesError is returned.
+// Response errors with a path into the i-th element of the nodes field, including errors of nested fields of the object,
+// are errors of the i-th ID. IDs that do not exist, or that do not resolve to an object of the expected type, have errors wrapping ErrNodeNotFound. Other
+// response errors of individual IDs (e.g. of type FORBIDDEN) are reported as is.
+// Any other error aborts Nodes and is returned as is. See Query for more information on errors and responses.
+func (c *Client) Nodes(ctx context.Context, ids []ID, slice any) (*http.Response, error) {
+	sliceValue := reflect.ValueOf(slice)
+	if sliceValue.Kind() != reflect.Ptr || sliceValue.IsNil() || sliceValue.Elem().Kind() != reflect.Slice ||
+		sliceValue.Elem().Type().Elem().Kind() != reflect.Ptr || sliceValue.Elem().Type().Elem().Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf(`error in (*Client).Nodes: slice has type %T, expected a pointer to a slice of pointers to `+
+			`structs`, slice)
+	}
+	elemType := sliceValue.Elem().Type().Elem().Elem()
+	typeName, err := fragmentTypeName(elemType)
+	if err != nil {
+		return nil, err
+	}
+	name, err := operationName(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var qb queryBuilder
+	qb.operationType("query", name)
+	qb.raw("($ids:[ID!]!){nodes(ids: $ids){__typename")
+	qb.commaFlag = true
+	qb.field("... on "+typeName, elemType)
+	qb.raw("}}")
+	operation := qb.String()
+	result := reflect.MakeSlice(sliceValue.Elem().Type(), len(ids), len(ids))
+	nodeErrs := make([]error, len(ids))
+	hasNodeErrs := false
+	var resp *http.Response
+	for start := 0; start < len(ids); start += maxNodeIDs {
+		end := start + maxNodeIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+		var data struct {
+			Nodes []map[string]json.RawMessage `json:"nodes"`
+		}
+		var errItems []graphql.ErrorItem
+		resp, errItems, err = c.send(ctx, request{
+			Query: operation,
+			Variables: map[string]any{
+				"ids": chunk,
+			},
+			OperationName: name,
+			isQuery:       true,
+		}, func(b []byte) error {
+			return json.Unmarshal(b, &data)
+		})
+		if err != nil {
+			// Errors with a path into an element of the nodes field are errors of individual IDs, any other error is fatal.
+			var fatal []graphql.ErrorItem
+			for _, errItem := range errItems {
+				if i, ok := nodesErrorIndex(errItem); ok && i < len(chunk) && resp.StatusCode == http.StatusOK {
+					itemErr := err
+					if isNotFound(errItem, "nodes", i) {
+						itemErr = ErrNodeNotFound
+					}
+					nodeErrs[start+i] = setOperationName(enhanceError(&graphql.Error{
+						Err:       itemErr,
+						Errors:    []graphql.ErrorItem{errItem},
+						Message:   fmt.Sprintf(`node %#v: %s`, chunk[i].S, errItem.Message),
+						Operation: operation,
+					}), name)
+					hasNodeErrs = true
+				} else {
+					fatal = append(fatal, errItem)
+				}
+			}
+			if len(errItems) == 0 || len(fatal) > 0 {
+				return resp, setOperationName(enhanceError(&graphql.Error{
+					Err:       err,
+					Errors:    errItems,
+					Message:   err.Error(),
+					Operation: operation,
+				}), name)
+			}
+		}
+		for i, id := range chunk {
+			if nodeErrs[start+i] != nil {
+				continue
+			}
+			var raw map[string]json.RawMessage
+			if i < len(data.Nodes) {
+				raw = data.Nodes[i]
+			}
+			elem := reflect.New(elemType)
+			if err := decodeNode(raw, id, typeName, elem.Interface()); err != nil {
+				nodeErrs[start+i] = err
+				hasNodeErrs = true
+				continue
+			}
+			result.Index(start + i).Set(elem)
+		}
+	}
+	sliceValue.Elem().Set(result)
+	if hasNodeErrs {
+		return resp, &NodesError{
+			Errors: nodeErrs,
+		}
+	}
+	return resp, nil
+}
+
+// fragmentTypeName returns the type condition of the inline fragment defined by struct type t.
+func fragmentTypeName(t reflect.Type) (string, error) {
+	name := strings.TrimSuffix(t.Name(), "Fragment")
+	r, size := utf8.DecodeRuneInString(name)
+	if size == 0 {
+		return "", fmt.Errorf(`cannot derive GraphQL type name from Go type %v`, t)
+	}
+	return string(unicode.ToUpper(r)) + name[size:], nil
+}
+
+// decodeNode decodes the JSON object raw, which has a __typename property, into fragment.
+// fragment must select at least one field.
+func decodeNode(raw map[string]json.RawMessage, id ID, typeName string, fragment any) error {
+	if raw == nil {
+		return fmt.Errorf(`node %#v: %w`, id.S, ErrNodeNotFound)
+	}
+	var actualTypeName string
+	_ = json.Unmarshal(raw["__typename"], &actualTypeName)
+	delete(raw, "__typename")
+	if len(raw) == 0 {
+		// The type condition of the inline fragment did not match.
+		// Comparing type names is not sufficient, because typeName can be the name of an interface.
+		return fmt.Errorf(`node %#v has type %s, which does not match %s: %w`, id.S, actualTypeName, typeName, ErrNodeNotFound)
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	if err := internalJSON.Unmarshal(b, fragment); err != nil {
+		return fmt.Errorf(`error decoding node %#v: %w`, id.S, err)
+	}
+	return nil
+}
+
+// isNotFound returns true if errItem has type NOT_FOUND and path path.
+func isNotFound(errItem graphql.ErrorItem, path ...any) bool {
+	var errType string
+	if err := json.Unmarshal(errItem.Raw["type"], &errType); err != nil || errType != "NOT_FOUND" {
+		return false
+	}
+	expected, _ := json.Marshal(path)
+	var actual []any
+	if err := json.Unmarshal(errItem.Raw["path"], &actual); err != nil {
+		return false
+	}
+	actualJSON, _ := json.Marshal(actual)
+	return string(actualJSON) == string(expected)
+}
+
+// nodesErrorIndex returns i if the path of errItem starts with ["nodes", i], e.g. ["nodes", i] or ["nodes", i, "title"].
+func nodesErrorIndex(errItem graphql.ErrorItem) (int, bool) {
+	var path []json.RawMessage
+	if err := json.Unmarshal(errItem.Raw["path"], &path); err != nil || len(path) < 2 || string(path[0]) != `"nodes"` {
+		return 0, false
+	}
+	i, err := strconv.Atoi(string(path[1]))
+	if err != nil || i < 0 {
+		return 0, false
+	}
+	return i, true
+}
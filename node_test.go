@@ -0,0 +1,169 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type IssueFragment struct {
+	Title string
+}
+
+func Test_Client_Node(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query($id:ID!){node(id: $id){__typename,... on Issue{title}}}", req.Query)
+			assert.JSONEq(t, `"I1"`, string(req.Variables["id"]))
+			return http.StatusOK, `{"data":{"node":{"__typename":"Issue","title":"t1"}}}`
+		})
+		var issue IssueFragment
+		_, err := c.Node(context.Background(), ID{S: "I1"}, &issue)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "t1", issue.Title)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"node":{"__typename":"PullRequest"}}}`
+		})
+		var issue IssueFragment
+		_, err := c.Node(context.Background(), ID{S: "PR1"}, &issue)
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+	})
+	t.Run("Case3", func(t *testing.T) {
+		c := NewClient(nil)
+		var issue struct {
+			Title string
+		}
+		_, err := c.Node(context.Background(), ID{S: "I1"}, &issue)
+		assert.ErrorContains(t, err, "cannot derive GraphQL type name")
+	})
+	t.Run("Case4", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"node":null},"errors":[{"type":"NOT_FOUND","path":["node"],` +
+				`"message":"Could not resolve to a node with the global id of 'I404'"}]}`
+		})
+		var issue IssueFragment
+		_, err := c.Node(context.Background(), ID{S: "I404"}, &issue)
+		assert.ErrorIs(t, err, ErrNodeNotFound)
+		if assert.IsType(t, &Error{}, err) {
+			assert.Len(t, err.(*Error).Errors, 1)
+		}
+	})
+	t.Run("Case5", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"node":null},"errors":[{"type":"FORBIDDEN","path":["node"],` +
+				`"message":"Resource not accessible by integration"}]}`
+		})
+		var issue IssueFragment
+		_, err := c.Node(context.Background(), ID{S: "I1"}, &issue)
+		assert.Error(t, err)
+		assert.False(t, errors.Is(err, ErrNodeNotFound))
+	})
+}
+
+func Test_Client_Nodes(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		// Nodes with even indices exist, nodes with odd indices do not.
+		var chunkSizes []int
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query($ids:[ID!]!){nodes(ids: $ids){__typename,... on Issue{title}}}", req.Query)
+			var ids []string
+			assert.NoError(t, json.Unmarshal(req.Variables["ids"], &ids))
+			chunkSizes = append(chunkSizes, len(ids))
+			var nodes, errItems []string
+			for i, id := range ids {
+				var n int
+				_, _ = fmt.Sscanf(id, "I%d", &n)
+				if n%2 == 0 {
+					nodes = append(nodes, fmt.Sprintf(`{"__typename":"Issue","title":"t%d"}`, n))
+				} else {
+					nodes = append(nodes, `null`)
+					errItems = append(errItems, fmt.Sprintf(`{"type":"NOT_FOUND","path":["nodes",%d],`+
+						`"message":"Could not resolve to a node with the global id of '%s'"}`, i, id))
+				}
+			}
+			return http.StatusOK, fmt.Sprintf(`{"data":{"nodes":[%s]},"errors":[%s]}`, strings.Join(nodes, ","),
+				strings.Join(errItems, ","))
+		})
+		var ids []ID
+		for i := 0; i < 150; i++ {
+			ids = append(ids, ID{S: fmt.Sprintf("I%d", i)})
+		}
+		var issues []*IssueFragment
+		_, err := c.Nodes(context.Background(), ids, &issues)
+		assert.Equal(t, []int{100, 50}, chunkSizes)
+		var nodesErr *NodesError
+		if assert.ErrorAs(t, err, &nodesErr) && assert.Len(t, issues, 150) {
+			assert.Len(t, nodesErr.Errors, 150)
+			for i, issue := range issues {
+				if i%2 == 0 {
+					if assert.NotNil(t, issue) {
+						assert.Equal(t, fmt.Sprintf("t%d", i), issue.Title)
+					}
+					assert.NoError(t, nodesErr.Errors[i])
+				} else {
+					assert.Nil(t, issue)
+					assert.ErrorIs(t, nodesErr.Errors[i], ErrNodeNotFound)
+				}
+			}
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":null,"errors":[{"message":"Variable $ids is invalid"}]}`
+		})
+		var issues []*IssueFragment
+		_, err := c.Nodes(context.Background(), []ID{{S: "I1"}}, &issues)
+		var nodesErr *NodesError
+		assert.False(t, errors.As(err, &nodesErr))
+		assert.IsType(t, &Error{}, err)
+	})
+	t.Run("Case3", func(t *testing.T) {
+		c := NewClient(nil)
+		var issues []IssueFragment
+		_, err := c.Nodes(context.Background(), nil, &issues)
+		assert.ErrorContains(t, err, "expected a pointer to a slice of pointers to structs")
+	})
+	t.Run("Case4", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"nodes":[null,null]},"errors":[` +
+				`{"type":"NOT_FOUND","path":["nodes",0],"message":"Could not resolve to a node with the global id of 'I0'"},` +
+				`{"type":"FORBIDDEN","path":["nodes",1],"message":"Resource not accessible by integration"}]}`
+		})
+		var issues []*IssueFragment
+		_, err := c.Nodes(context.Background(), []ID{{S: "I0"}, {S: "I1"}}, &issues)
+		var nodesErr *NodesError
+		if assert.ErrorAs(t, err, &nodesErr) && assert.Len(t, nodesErr.Errors, 2) {
+			assert.ErrorIs(t, nodesErr.Errors[0], ErrNodeNotFound)
+			assert.Error(t, nodesErr.Errors[1])
+			assert.False(t, errors.Is(nodesErr.Errors[1], ErrNodeNotFound))
+		}
+	})
+	t.Run("Case5", func(t *testing.T) {
+		// An error of a nested field is an error of the ID at its index.
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"nodes":[{"__typename":"Issue","title":"t0"},{"__typename":"Issue","title":null}]},` +
+				`"errors":[{"type":"NOT_FOUND","path":["nodes",1,"title"],"message":"Could not resolve title"}]}`
+		})
+		var issues []*IssueFragment
+		_, err := c.Nodes(context.Background(), []ID{{S: "I0"}, {S: "I1"}}, &issues)
+		var nodesErr *NodesError
+		if assert.ErrorAs(t, err, &nodesErr) && assert.Len(t, nodesErr.Errors, 2) && assert.Len(t, issues, 2) {
+			assert.NoError(t, nodesErr.Errors[0])
+			if assert.NotNil(t, issues[0]) {
+				assert.Equal(t, "t0", issues[0].Title)
+			}
+			assert.ErrorContains(t, nodesErr.Errors[1], "Could not resolve title")
+			assert.False(t, errors.Is(nodesErr.Errors[1], ErrNodeNotFound))
+			assert.Nil(t, issues[1])
+		}
+	})
+}
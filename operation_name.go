@@ -0,0 +1,52 @@
+package githubv4
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// OperationNamer can be implemented by structs that define GraphQL queries/mutations to name their operation.
+// Named operations can be identified in GitHub-side logs, proxy logs and support tickets.
+// See also WithOperationName.
+type OperationNamer interface {
+	OperationName() string
+}
+
+type operationNameContextKey struct{}
+
+// WithOperationName returns a context that makes operations of *Client named name.
+// name takes precedence over names of OperationNamer implementations.
+func WithOperationName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationNameContextKey{}, name)
+}
+
+// nameRegexp matches a Name. See https://spec.graphql.org/October2021/#Name.
+var nameRegexp = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// operationName returns the name of the operation defined by q (see WithOperationName and OperationNamer), or the empty
+// string if the operation is anonymous.
+func operationName(ctx context.Context, q any) (string, error) {
+	if name, _ := ctx.Value(operationNameContextKey{}).(string); name != "" {
+		return checkOperationName(name)
+	}
+	return namerOperationName(q)
+}
+
+// namerOperationName returns the name of the operation defined by q if q implements OperationNamer, or the empty string
+// otherwise.
+func namerOperationName(q any) (string, error) {
+	var name string
+	if namer, ok := q.(OperationNamer); ok {
+		name = namer.OperationName()
+	}
+	return checkOperationName(name)
+}
+
+// checkOperationName returns name, or an error if name is neither empty nor a valid Name.
+func checkOperationName(name string) (string, error) {
+	if name != "" && !nameRegexp.MatchString(name) {
+		return "", fmt.Errorf(`invalid operation name %#v`, name)
+	}
+	return name, nil
+}
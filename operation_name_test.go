@@ -0,0 +1,82 @@
+package githubv4
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type namedViewerQuery struct {
+	Viewer struct {
+		Login string
+	}
+}
+
+func (namedViewerQuery) OperationName() string {
+	return "ViewerLogin"
+}
+
+func Test_operationName(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		name, err := operationName(context.Background(), &namedViewerQuery{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "ViewerLogin", name)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		name, err := operationName(WithOperationName(context.Background(), "Other"), &namedViewerQuery{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "Other", name)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		name, err := namerOperationName(struct{}{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "", name)
+		}
+	})
+	t.Run("Case4", func(t *testing.T) {
+		_, err := operationName(WithOperationName(context.Background(), "not valid"), nil)
+		assert.ErrorContains(t, err, `invalid operation name "not valid"`)
+	})
+}
+
+func Test_Client_Query_OperationName(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query ViewerLogin{viewer{login}}", req.Query)
+			assert.Equal(t, "ViewerLogin", req.OperationName)
+			return http.StatusOK, `{"data":{"viewer":{"login":"gopher"}}}`
+		})
+		var md Metadata
+		var q namedViewerQuery
+		_, err := c.Query(WithMetadata(context.Background(), &md), &q, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "ViewerLogin", md.OperationName)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query Login{viewer{login}}", req.Query)
+			assert.Equal(t, "Login", req.OperationName)
+			return http.StatusOK, `{"data":null,"errors":[{"message":"m"}]}`
+		})
+		var q struct {
+			Viewer struct {
+				Login string
+			}
+		}
+		_, err := c.Query(WithOperationName(context.Background(), "Login"), &q, nil)
+		if assert.IsType(t, &Error{}, err) {
+			assert.Equal(t, "Login", err.(*Error).OperationName)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		document, _, err := Render(&namedViewerQuery{}, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "query ViewerLogin{viewer{login}}", document)
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package githubv4
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Optional is the value of a nullable field of an input object. Unlike a pointer, Optional distinguishes between a field
+// that is unset, which is omitted when sent, and a field that is explicitly null. For example, this clears the milestone of
+// an issue:
+//
+//	githubv4.UpdateIssueInput{
+//		ID:          issueID,
+//		MilestoneID: githubv4.Null[githubv4.ID](),
+//	}
+//
+// The zero value of Optional is unset.
+//
+// Code that used pointers for nullable fields can use FromPtr and (Optional).Ptr, which map nil to unset and vice versa.
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+type optionalState uint8
+
+const (
+	optionalUnset optionalState = iota
+	optionalNull
+	optionalValue
+)
+
+// Some returns an Optional with value v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{
+		value: v,
+		state: optionalValue,
+	}
+}
+
+// Null returns an Optional that is explicitly null.
+func Null[T any]() Optional[T] {
+	return Optional[T]{
+		state: optionalNull,
+	}
+}
+
+// FromPtr returns an Optional with value *p, or an unset Optional if p is nil.
+// FromPtr never returns a null Optional, so FromPtr(p) is sent exactly like p would be sent in a field with a pointer type
+// and omitempty.
+func FromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return Optional[T]{}
+	}
+	return Some(*p)
+}
+
+// IsSet returns true if o is null or has a value.
+func (o Optional[T]) IsSet() bool {
+	return o.state != optionalUnset
+}
+
+// IsNull returns true if o is explicitly null.
+func (o Optional[T]) IsNull() bool {
+	return o.state == optionalNull
+}
+
+// Value returns the value of o. Returns false if o is unset or null.
+func (o Optional[T]) Value() (T, bool) {
+	return o.value, o.state == optionalValue
+}
+
+// Ptr returns a pointer to a copy of the value of o, or nil if o is unset or null.
+func (o Optional[T]) Ptr() *T {
+	if o.state != optionalValue {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
+// IsZero returns true if o is unset.
+func (o Optional[T]) IsZero() bool {
+	return o.state == optionalUnset
+}
+
+// String implements fmt.Stringer.
+func (o Optional[T]) String() string {
+	switch o.state {
+	case optionalNull:
+		return "null"
+	case optionalValue:
+		return fmt.Sprint(o.value)
+	}
+	return "unset"
+}
+
+// MarshalJSON implements json.Marshaler. An unset Optional is encoded as null, but is omitted from input objects.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != optionalValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *Optional[T]) UnmarshalJSON(b []byte) error {
+	if string(bytes.TrimSpace(b)) == "null" {
+		*o = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+func (o Optional[T]) isUnset() bool {
+	return o.state == optionalUnset
+}
+
+// optional is implemented by Optional[T] for all T.
+type optional interface {
+	isUnset() bool
+}
+
+var optionalType = reflect.TypeOf((*optional)(nil)).Elem()
+
+// marshalInput encodes input object x (a struct) as JSON, omitting fields that are unset Optionals and fields with option
+// omitempty that have empty values. Input objects with Optional fields implement json.Marshaler using marshalInput.
+func marshalInput(x any) ([]byte, error) {
+	v := reflect.ValueOf(x)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return []byte("null"), nil
+		}
+		v = v.Elem()
+	}
+	var b bytes.Buffer
+	b.WriteByte('{')
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fv := v.Field(i)
+		if f.Type.Implements(optionalType) && fv.Interface().(optional).isUnset() {
+			continue
+		}
+		if hasOption(opts, "omitempty") && isEmptyValue(fv) {
+			continue
+		}
+		fieldJSON, err := json.Marshal(fv.Interface())
+		if err != nil {
+			return nil, fmt.Errorf(`error encoding field %s of %v: %w`, f.Name, t, err)
+		}
+		if b.Len() > 1 {
+			b.WriteByte(',')
+		}
+		nameJSON, _ := json.Marshal(name)
+		b.Write(nameJSON)
+		b.WriteByte(':')
+		b.Write(fieldJSON)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// hasOption returns true if the comma-separated options of a json struct field tag include option.
+func hasOption(opts string, option string) bool {
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyValue returns true if v is empty as defined by the omitempty option of encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
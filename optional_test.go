@@ -0,0 +1,82 @@
+package githubv4
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Optional(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		var o Optional[string]
+		assert.False(t, o.IsSet())
+		assert.False(t, o.IsNull())
+		assert.Nil(t, o.Ptr())
+		assert.Equal(t, "unset", o.String())
+	})
+	t.Run("Case2", func(t *testing.T) {
+		o := Null[string]()
+		assert.True(t, o.IsSet())
+		assert.True(t, o.IsNull())
+		_, ok := o.Value()
+		assert.False(t, ok)
+		assert.Nil(t, o.Ptr())
+	})
+	t.Run("Case3", func(t *testing.T) {
+		o := Some("a")
+		assert.True(t, o.IsSet())
+		assert.False(t, o.IsNull())
+		v, ok := o.Value()
+		assert.True(t, ok)
+		assert.Equal(t, "a", v)
+		if assert.NotNil(t, o.Ptr()) {
+			assert.Equal(t, "a", *o.Ptr())
+		}
+	})
+	t.Run("Case4", func(t *testing.T) {
+		s := "a"
+		assert.Equal(t, Some("a"), FromPtr(&s))
+		assert.Equal(t, Optional[string]{}, FromPtr[string](nil))
+	})
+	t.Run("Case5", func(t *testing.T) {
+		var o struct {
+			A Optional[int]
+			B Optional[int]
+		}
+		if assert.NoError(t, json.Unmarshal([]byte(`{"A":null,"B":1}`), &o)) {
+			assert.Equal(t, Null[int](), o.A)
+			assert.Equal(t, Some(1), o.B)
+		}
+	})
+}
+
+func Test_marshalInput(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		input := UpdateIssueInput{
+			ID:          ID{S: "I0"},
+			Title:       Some("t"),
+			MilestoneID: Null[ID](),
+		}
+		b, err := json.Marshal(input)
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{"id":"I0","title":"t","milestoneId":null}`, string(b))
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		b, err := json.Marshal(&UpdateIssueInput{ID: ID{S: "I0"}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{"id":"I0"}`, string(b))
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		b, err := marshalInput(struct {
+			A string `json:"a,omitempty"`
+			B string `json:"-"`
+			C Optional[[]string]
+		}{B: "b", C: Some([]string{"c"})})
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{"C":["c"]}`, string(b))
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package githubv4
+
+// ClientOption configures a *Client. See NewClient and NewEnterpriseClient.
+type ClientOption func(c *Client)
+
+// Logger is implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// WithLogger makes the *Client log to l.
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// WithNodeLimit makes Query, Mutate and MutateInputs reject operations with a node count (see AnalyzeCost) above limit before
+// they are sent, by returning a *NodeLimitError. Use MaxNodeCount to only reject operations that GitHub would reject.
+// Operations whose node count cannot be determined, e.g. because the variable of a first argument is nil, are sent without
+// checking the limit.
+func WithNodeLimit(limit int) ClientOption {
+	return func(c *Client) {
+		c.nodeLimit = limit
+	}
+}
+
+// WithRateLimitCapture makes the *Client add the rateLimit field to the queries it constructs (i.e. not to mutations and not
+// to documents sent with Exec), under a reserved alias that root fields of queries must not use. The field is removed from the
+// response data before it is decoded, and the rate limit status it reports is set in Metadata and recorded as the rate limit
+// status of the *Client (see (*Client).RateLimit).
+func WithRateLimitCapture() ClientOption {
+	return func(c *Client) {
+		c.captureRateLimit = true
+	}
+}
+
+// WithInputValidation makes Mutate, MutateInputs and BulkMutate validate generated input objects before they are sent (see
+// the Validate methods of input objects). Invalid inputs are not sent, and are reported by a *ValidationError whose field
+// paths start with the name of the input variable, e.g. "input.labelIds[1]".
+func WithInputValidation() ClientOption {
+	return func(c *Client) {
+		c.validateInputs = true
+	}
+}
+
+// WithClientMutationIDs makes Mutate, MutateInputs and BulkMutate set the ClientMutationID of inputs that do not already have
+// one. generate generates unique client mutation IDs. If generate is nil then random 128-bit hexadecimal IDs are generated.
+//
+// If the mutation selects the clientMutationId field of a payload then the echoed client mutation ID is verified.
+// Client mutation IDs are reported in Metadata, in errors of type *Error and in logs (see WithLogger).
+func WithClientMutationIDs(generate func() string) ClientOption {
+	return func(c *Client) {
+		if generate == nil {
+			c.generateClientMutationID = randomClientMutationID
+			return
+		}
+		c.generateClientMutationID = func() (string, error) {
+			return generate(), nil
+		}
+	}
+}
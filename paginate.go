@@ -0,0 +1,244 @@
+package githubv4
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Cursors maps names of cursor variables to cursor values.
+// A nil cursor value is sent as null, which selects the first page of a connection.
+type Cursors map[string]*string
+
+// PageInfo can be used to select the pageInfo field of a connection. For example:
+//
+//	Comments struct {
+//		Nodes    []comment
+//		PageInfo githubv4.PageInfo
+//	} `graphql:"comments(first: 100, after: $commentsCursor)"`
+type PageInfo struct {
+	EndCursor   *string
+	HasNextPage bool
+}
+
+// Next returns the cursors that select the next page of the connection, given that the after argument of the connection
+// is bound to the variable named name.
+// Returns nil if there is no next page.
+func (p PageInfo) Next(name string) Cursors {
+	if !p.HasNextPage {
+		return nil
+	}
+	return Cursors{name: p.EndCursor}
+}
+
+// PageFunc is called by (*Client).Paginate after a page has been decoded into the query struct.
+// PageFunc processes the page and returns the cursors of the next page, which are merged into the variables of the next query.
+// If the query has nested connections then all cursors that change should be returned. For example, when moving on to the next
+// page of an outer connection the cursor of the inner connection should be reset to nil.
+// Returning nil or empty cursors ends pagination.
+type PageFunc func(ctx context.Context) (next Cursors, err error)
+
+// PaginateOptions are options for (*Client).Paginate.
+type PaginateOptions struct {
+	// CheckpointStore, if not nil, receives the cursor state after each page that was successfully processed.
+	// If CheckpointStore has a checkpoint for JobKey when pagination starts, then pagination resumes from that checkpoint.
+	// The checkpoint is deleted when pagination completes.
+	CheckpointStore CheckpointStore
+
+	// JobKey identifies the pagination job in CheckpointStore. JobKey is required if CheckpointStore is not nil.
+	JobKey string
+
+	// PageSize, if not nil, enables adaptive page sizes.
+	PageSize *AdaptivePageSize
+}
+
+// AdaptivePageSize configures adaptive page sizes for (*Client).Paginate.
+// If a query fails with what looks like a server-side timeout (see IsTimeout), the same page is queried again with half the
+// page size. After consecutive successes the page size grows again, up to Max.
+type AdaptivePageSize struct {
+	// Variable is the name of the variable bound to the first or last argument of the connection(s), for example:
+	//	`graphql:"comments(first: $pageSize, after: $commentsCursor)"`
+	// The value of Variable in the variables passed to (*Client).Paginate is ignored.
+	Variable string
+
+	// Max is the initial and maximum page size. Defaults to 100.
+	Max int
+
+	// Min is the minimum page size. If a query with page size Min fails then pagination stops with the error. Defaults to 1.
+	Min int
+
+	// GrowAfter is the number of consecutive successful queries after which the page size grows by 50%. Defaults to 3.
+	GrowAfter int
+}
+
+// pageSizer tracks the current page size of an AdaptivePageSize.
+type pageSizer struct {
+	cfg       AdaptivePageSize
+	size      int
+	successes int
+}
+
+func newPageSizer(cfg AdaptivePageSize) *pageSizer {
+	if cfg.Max <= 0 {
+		cfg.Max = 100
+	}
+	if cfg.Min <= 0 {
+		cfg.Min = 1
+	}
+	if cfg.Min > cfg.Max {
+		cfg.Min = cfg.Max
+	}
+	if cfg.GrowAfter <= 0 {
+		cfg.GrowAfter = 3
+	}
+	return &pageSizer{
+		cfg:  cfg,
+		size: cfg.Max,
+	}
+}
+
+// shrink halves the page size. Returns false if the page size is already the minimum.
+func (p *pageSizer) shrink() bool {
+	p.successes = 0
+	if p.size <= p.cfg.Min {
+		return false
+	}
+	p.size /= 2
+	if p.size < p.cfg.Min {
+		p.size = p.cfg.Min
+	}
+	return true
+}
+
+// success records a successful query, and grows the page size after GrowAfter consecutive successes.
+func (p *pageSizer) success() {
+	p.successes++
+	if p.successes < p.cfg.GrowAfter || p.size >= p.cfg.Max {
+		return
+	}
+	p.successes = 0
+	p.size += (p.size + 1) / 2
+	if p.size > p.cfg.Max {
+		p.size = p.cfg.Max
+	}
+}
+
+// IsTimeout returns true if resp and err returned by a query indicate that the query timed out on the server, which
+// typically happens for large pages with deep selection sets.
+func IsTimeout(resp *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	var gErr *Error
+	if errors.As(err, &gErr) {
+		for _, errItem := range gErr.Errors {
+			if strings.Contains(errItem.Message, "This may be the result of a timeout") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Paginate does query operations until f returns nil or empty cursors.
+// q is a pointer to a struct that defines the GraphQL query, and receives the data of each page. *q is reset to its zero value
+// before each query.
+// variables are the initial variables (see Query). variables is not modified.
+//
+// Returns the *http.Response of the last query. See Query for more information on errors and responses.
+func (c *Client) Paginate(ctx context.Context, q any, variables any, f PageFunc, opts *PaginateOptions) (
+	*http.Response, error) {
+	if opts == nil {
+		opts = &PaginateOptions{}
+	}
+	if opts.CheckpointStore != nil && opts.JobKey == "" {
+		return nil, fmt.Errorf(`error in (*Client).Paginate: a checkpoint store requires a job key`)
+	}
+	qValue := reflect.ValueOf(q)
+	if qValue.Kind() != reflect.Pointer || qValue.IsNil() {
+		return nil, fmt.Errorf(`error in (*Client).Paginate: q has non-pointer or nil type %T`, q)
+	}
+	variablesByName, err := variablesMap(q, variables, nil)
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]any, len(variablesByName))
+	for k, v := range variablesByName {
+		vars[k] = v
+	}
+	var sizer *pageSizer
+	if opts.PageSize != nil {
+		if opts.PageSize.Variable == "" {
+			return nil, fmt.Errorf(`error in (*Client).Paginate: adaptive page size requires a variable name`)
+		}
+		sizer = newPageSizer(*opts.PageSize)
+	}
+	cursors := Cursors{}
+	pages := 0
+	if opts.CheckpointStore != nil {
+		checkpoint, err := opts.CheckpointStore.Load(ctx, opts.JobKey)
+		if err != nil {
+			return nil, fmt.Errorf(`error loading checkpoint of job %#v: %w`, opts.JobKey, err)
+		}
+		if checkpoint != nil {
+			for name, cursor := range checkpoint.Cursors {
+				cursors[name] = cursor
+				setVariable(vars, name, cursor)
+			}
+			pages = checkpoint.Pages
+		}
+	}
+	for {
+		qValue.Elem().SetZero()
+		if sizer != nil {
+			setVariable(vars, opts.PageSize.Variable, sizer.size)
+		}
+		resp, err := c.Query(ctx, q, vars)
+		if sizer != nil {
+			if IsTimeout(resp, err) && sizer.shrink() {
+				continue
+			}
+			if err == nil {
+				sizer.success()
+			}
+		}
+		if err != nil {
+			return resp, err
+		}
+		next, err := f(ctx)
+		if err != nil {
+			return resp, err
+		}
+		pages++
+		if len(next) == 0 {
+			if opts.CheckpointStore != nil {
+				if err := opts.CheckpointStore.Delete(ctx, opts.JobKey); err != nil {
+					return resp, fmt.Errorf(`error deleting checkpoint of job %#v: %w`, opts.JobKey, err)
+				}
+			}
+			return resp, nil
+		}
+		for name, cursor := range next {
+			cursors[name] = cursor
+			setVariable(vars, name, cursor)
+		}
+		if opts.CheckpointStore != nil {
+			checkpoint := &Checkpoint{
+				Cursors: cursors,
+				Pages:   pages,
+			}
+			if err := opts.CheckpointStore.Save(ctx, opts.JobKey, checkpoint); err != nil {
+				return resp, fmt.Errorf(`error saving checkpoint of job %#v: %w`, opts.JobKey, err)
+			}
+		}
+	}
+}
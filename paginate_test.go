@@ -0,0 +1,237 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type paginateTestQuery struct {
+	Viewer struct {
+		Repositories struct {
+			Nodes []struct {
+				Name string
+			}
+			PageInfo PageInfo
+		} `graphql:"repositories(first: 2, after: $cursor)"`
+	}
+}
+
+// paginateTestHandler serves pages of the repositories of the viewer, where the cursor of each page is the page index.
+func paginateTestHandler(t *testing.T, requests *[]string) func(req testRequest) (int, string) {
+	return func(req testRequest) (int, string) {
+		var cursor *string
+		assert.NoError(t, json.Unmarshal(req.Variables["cursor"], &cursor))
+		page := 0
+		if cursor != nil {
+			_, err := fmt.Sscan(*cursor, &page)
+			assert.NoError(t, err)
+		}
+		*requests = append(*requests, fmt.Sprint(page))
+		return http.StatusOK, fmt.Sprintf(`{"data":{"viewer":{"repositories":{"nodes":[{"name":"r%d"}],`+
+			`"pageInfo":{"endCursor":"%d","hasNextPage":%t}}}}}`, page, page+1, page < 2)
+	}
+}
+
+func Test_Client_Paginate(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		var requests []string
+		c := newTestClient(t, paginateTestHandler(t, &requests))
+		var q paginateTestQuery
+		variables := map[string]any{
+			"cursor": (*string)(nil),
+		}
+		var names []string
+		_, err := c.Paginate(context.Background(), &q, variables, func(ctx context.Context) (Cursors, error) {
+			for _, node := range q.Viewer.Repositories.Nodes {
+				names = append(names, node.Name)
+			}
+			return q.Viewer.Repositories.PageInfo.Next("cursor"), nil
+		}, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"r0", "r1", "r2"}, names)
+			assert.Equal(t, map[string]any{"cursor": (*string)(nil)}, variables)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		// Resumes from checkpoint after failure.
+		var requests []string
+		c := newTestClient(t, paginateTestHandler(t, &requests))
+		store := NewMemoryCheckpointStore()
+		opts := &PaginateOptions{
+			CheckpointStore: store,
+			JobKey:          "job1",
+		}
+		var q paginateTestQuery
+		variables := map[string]any{
+			"cursor": (*string)(nil),
+		}
+		errBoom := errors.New("boom")
+		fail := true
+		var names []string
+		f := func(ctx context.Context) (Cursors, error) {
+			for _, node := range q.Viewer.Repositories.Nodes {
+				if node.Name == "r2" && fail {
+					return nil, errBoom
+				}
+				names = append(names, node.Name)
+			}
+			return q.Viewer.Repositories.PageInfo.Next("cursor"), nil
+		}
+		_, err := c.Paginate(context.Background(), &q, variables, f, opts)
+		assert.Same(t, errBoom, err)
+		checkpoint, err := store.Load(context.Background(), "job1")
+		if assert.NoError(t, err) && assert.NotNil(t, checkpoint) {
+			assert.Equal(t, 2, checkpoint.Pages)
+			cursor := "2"
+			assert.Equal(t, Cursors{"cursor": &cursor}, checkpoint.Cursors)
+		}
+		fail = false
+		requests = nil
+		_, err = c.Paginate(context.Background(), &q, variables, f, opts)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"2"}, requests)
+			assert.Equal(t, []string{"r0", "r1", "r2"}, names)
+		}
+		checkpoint, err = store.Load(context.Background(), "job1")
+		if assert.NoError(t, err) {
+			assert.Nil(t, checkpoint)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		c := NewClient(nil)
+		var q paginateTestQuery
+		_, err := c.Paginate(context.Background(), &q, nil, nil, &PaginateOptions{
+			CheckpointStore: NewMemoryCheckpointStore(),
+		})
+		assert.ErrorContains(t, err, "requires a job key")
+	})
+	t.Run("Case4", func(t *testing.T) {
+		// Empty cursors end pagination.
+		var requests []string
+		c := newTestClient(t, paginateTestHandler(t, &requests))
+		var q paginateTestQuery
+		_, err := c.Paginate(context.Background(), &q, map[string]any{"cursor": (*string)(nil)},
+			func(ctx context.Context) (Cursors, error) {
+				return Cursors{}, nil
+			}, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"0"}, requests)
+		}
+	})
+	t.Run("Case5", func(t *testing.T) {
+		// Cursors keep the GraphQL type declared by the variables struct.
+		var requests []string
+		handler := paginateTestHandler(t, &requests)
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Contains(t, req.Query, "($cursor:String!)")
+			return handler(req)
+		})
+		var q paginateTestQuery
+		first := "0"
+		variables := struct {
+			Cursor *string `gqlvar:"cursor,type=String!"`
+		}{
+			Cursor: &first,
+		}
+		_, err := c.Paginate(context.Background(), &q, variables, func(ctx context.Context) (Cursors, error) {
+			return q.Viewer.Repositories.PageInfo.Next("cursor"), nil
+		}, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"0", "1", "2"}, requests)
+		}
+	})
+}
+
+func Test_Client_Paginate_AdaptivePageSize(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		// Queries with more than 25 items per page time out.
+		var pageSizes []int
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			var pageSize int
+			assert.NoError(t, json.Unmarshal(req.Variables["pageSize"], &pageSize))
+			pageSizes = append(pageSizes, pageSize)
+			if pageSize > 25 {
+				return http.StatusBadGateway, `{}`
+			}
+			return http.StatusOK, fmt.Sprintf(`{"data":{"viewer":{"repositories":{`+
+				`"pageInfo":{"endCursor":"c","hasNextPage":%t}}}}}`, len(pageSizes) < 8)
+		})
+		var q struct {
+			Viewer struct {
+				Repositories struct {
+					PageInfo PageInfo
+				} `graphql:"repositories(first: $pageSize, after: $cursor)"`
+			}
+		}
+		_, err := c.Paginate(context.Background(), &q, map[string]any{"cursor": (*string)(nil)}, func(ctx context.Context) (Cursors, error) {
+			return q.Viewer.Repositories.PageInfo.Next("cursor"), nil
+		}, &PaginateOptions{
+			PageSize: &AdaptivePageSize{
+				Variable:  "pageSize",
+				Max:       100,
+				GrowAfter: 2,
+			},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []int{100, 50, 25, 25, 38, 19, 19, 29, 14}, pageSizes)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusGatewayTimeout, `{}`
+		})
+		var q paginateTestQuery
+		resp, err := c.Paginate(context.Background(), &q, nil, nil, &PaginateOptions{
+			PageSize: &AdaptivePageSize{
+				Variable: "pageSize",
+				Max:      4,
+				Min:      2,
+			},
+		})
+		assert.Error(t, err)
+		if assert.NotNil(t, resp) {
+			assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+		}
+	})
+}
+
+func Test_IsTimeout(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		assert.False(t, IsTimeout(&http.Response{StatusCode: http.StatusBadGateway}, nil))
+	})
+	t.Run("Case2", func(t *testing.T) {
+		assert.True(t, IsTimeout(&http.Response{StatusCode: http.StatusBadGateway}, errors.New("x")))
+	})
+	t.Run("Case3", func(t *testing.T) {
+		err := &Error{
+			Errors: []ErrorItem{
+				{
+					Message: "Something went wrong while executing your query. This may be the result of a timeout, or " +
+						"it could be a GitHub bug.",
+				},
+			},
+		}
+		assert.True(t, IsTimeout(&http.Response{StatusCode: http.StatusOK}, err))
+	})
+	t.Run("Case4", func(t *testing.T) {
+		assert.False(t, IsTimeout(&http.Response{StatusCode: http.StatusOK}, &Error{}))
+	})
+}
+
+func Test_PageInfo(t *testing.T) {
+	t.Run("Next", func(t *testing.T) {
+		t.Run("Case1", func(t *testing.T) {
+			assert.Nil(t, PageInfo{}.Next("c"))
+		})
+		t.Run("Case2", func(t *testing.T) {
+			cursor := "x"
+			assert.Equal(t, Cursors{"c": &cursor}, PageInfo{EndCursor: &cursor, HasNextPage: true}.Next("c"))
+		})
+	})
+}
@@ -0,0 +1,198 @@
+package githubv4
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/jbrekelmans/go-graphql/mapping"
+)
+
+// packagePath is the import path of this package.
+var packagePath = reflect.TypeOf(Client{}).PkgPath()
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// queryBuilder constructs GraphQL operations from Go structs.
+// queryBuilder mirrors the query builder of github.com/jbrekelmans/go-graphql, except that:
+//  1. variables are declared in a deterministic order;
+//  2. types defined in this package (like enums) are declared by their Go type name, so that a variable of type SearchType is
+//     declared as SearchType instead of String; -and
+//  3. structs that unmarshal themselves (like ID and DateTime) are selected as scalars, i.e. without a selection set.
+type queryBuilder struct {
+	b         bytes.Buffer
+	commaFlag bool
+
+	// rename, if not nil, is applied to the GraphQL snippet of each field (see mapping.FieldInfo).
+	rename func(graphQL string) string
+}
+
+// constructOperation constructs a GraphQL operation (query or mutation) with selection set defined by q and variables declared
+// according to the types of the variable values. The operation is anonymous if operationName is the empty string.
+func constructOperation(operationType string, operationName string, q any, variables map[string]any) (string, error) {
+	var qb queryBuilder
+	if err := qb.operation(operationType, operationName, q, variables); err != nil {
+		return "", err
+	}
+	return qb.String(), nil
+}
+
+func (qb *queryBuilder) operation(operationType string, operationName string, q any, variables map[string]any) error {
+	qb.operationType(operationType, operationName)
+	qb.varDefs(variables)
+	n := qb.b.Len()
+	qb.selectionSetHelper(reflect.TypeOf(q), false)
+	if qb.b.Len() == n {
+		return fmt.Errorf(`invalid %s type %T`, operationType, q)
+	}
+	return nil
+}
+
+// operationType writes the operation type, followed by the operation name if it is not empty.
+func (qb *queryBuilder) operationType(operationType string, operationName string) {
+	qb.raw(operationType)
+	if operationName != "" {
+		qb.b.WriteByte(' ')
+		qb.raw(operationName)
+	}
+}
+
+func (qb *queryBuilder) selectionSetHelper(t reflect.Type, inline bool) (notEmpty bool) {
+	if t == nil {
+		return
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		if qb.selectionSetHelper(t.Elem(), false) {
+			notEmpty = true
+		}
+	case reflect.Struct:
+		if isScalarStruct(t) {
+			return
+		}
+		if !inline {
+			qb.b.WriteByte('{')
+			qb.commaFlag = false
+			notEmpty = true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			x := mapping.NewFieldInfo(f)
+			notEmpty = true
+			if !x.Inline() {
+				qb.field(x.GraphQL(), f.Type)
+			} else {
+				qb.selectionSetHelper(f.Type, true)
+			}
+		}
+		if !inline {
+			qb.b.WriteByte('}')
+			qb.commaFlag = false
+		}
+	}
+	return
+}
+
+// field writes a field with GraphQL snippet graphQL, followed by the selection set defined by t (if any).
+func (qb *queryBuilder) field(graphQL string, t reflect.Type) {
+	if qb.commaFlag {
+		qb.b.WriteByte(',')
+		qb.commaFlag = false
+	}
+	if qb.rename != nil {
+		graphQL = qb.rename(graphQL)
+	}
+	qb.raw(graphQL)
+	if isEmpty := !qb.selectionSetHelper(t, false); isEmpty {
+		qb.commaFlag = true
+	}
+}
+
+// isScalarStruct returns true if t is a struct type that unmarshals itself from JSON or text.
+func isScalarStruct(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return pt.Implements(jsonUnmarshalerType) || pt.Implements(textUnmarshalerType)
+}
+
+func (qb *queryBuilder) raw(s string) {
+	qb.b.WriteString(s)
+}
+
+func (qb *queryBuilder) String() string {
+	return qb.b.String()
+}
+
+func (qb *queryBuilder) varDefs(variables map[string]any) {
+	// https://spec.graphql.org/October2021/#VariableDefinitions
+	if len(variables) == 0 {
+		return
+	}
+	varNames := make([]string, 0, len(variables))
+	for varName := range variables {
+		varNames = append(varNames, varName)
+	}
+	sort.Strings(varNames)
+	qb.b.WriteByte('(')
+	for i, varName := range varNames {
+		if i > 0 {
+			qb.b.WriteByte(',')
+		}
+		qb.b.WriteByte('$')
+		qb.raw(varName)
+		qb.b.WriteByte(':')
+		qb.raw(variableType(variables[varName]))
+	}
+	qb.b.WriteByte(')')
+}
+
+// graphQLType returns the GraphQL type that a variable with Go type t is declared as.
+// Pointer types map to nullable types, all other types map to non-null types.
+// Standard Go types time.Time, []byte, big.Int and url.URL map to GitHub scalars DateTime, Base64String, BigInt and URI
+// respectively, so a *big.Int maps to BigInt and a big.Int maps to BigInt!.
+func graphQLType(t reflect.Type) string {
+	if t == nil {
+		// Untyped nil.
+		return "String"
+	}
+	nonNull := true
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nonNull = false
+	}
+	var s string
+	switch {
+	case standardScalars[t] != "":
+		s = standardScalars[t]
+	case t.PkgPath() == packagePath && t.Name() != "":
+		s = t.Name()
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		s = "[" + graphQLType(t.Elem()) + "]"
+	default:
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			s = "Int"
+		case reflect.String:
+			s = "String"
+		case reflect.Float32, reflect.Float64:
+			s = "Float"
+		case reflect.Bool:
+			s = "Boolean"
+		default:
+			s = t.Name()
+		}
+	}
+	if nonNull {
+		s += "!"
+	}
+	return s
+}
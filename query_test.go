@@ -0,0 +1,100 @@
+package githubv4
+
+import (
+	"math/big"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_constructOperation(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		var q struct {
+			Repository struct {
+				Name  string
+				Owner struct {
+					Login string
+				}
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}
+		operation, err := constructOperation("query", "", &q, map[string]any{
+			"owner": "o",
+			"name":  "n",
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "query($name:String!,$owner:String!){repository(owner: $owner, name: $name){name,owner{login}}}",
+				operation)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		var q int
+		_, err := constructOperation("query", "", &q, nil)
+		assert.ErrorContains(t, err, "invalid query type *int")
+	})
+	t.Run("Case3", func(t *testing.T) {
+		// Regression test for the differences with the query builder of github.com/jbrekelmans/go-graphql: variables are
+		// declared in sorted order, variables of types of this package are declared by their Go type name, and structs that
+		// unmarshal themselves are selected as scalars.
+		var q struct {
+			Search struct {
+				Nodes []struct {
+					Issue struct {
+						ID        ID
+						CreatedAt DateTime
+					} `graphql:"... on Issue"`
+				}
+			} `graphql:"search(query: $query, type: $type, first: $first)"`
+		}
+		for i := 0; i < 10; i++ {
+			operation, err := constructOperation("query", "", &q, map[string]any{
+				"type":  SearchTypeIssue,
+				"query": "q",
+				"first": 1,
+			})
+			if assert.NoError(t, err) {
+				assert.Equal(t, "query($first:Int!,$query:String!,$type:SearchType!)"+
+					"{search(query: $query, type: $type, first: $first){nodes{... on Issue{id,createdAt}}}}", operation)
+			}
+		}
+	})
+}
+
+func Test_graphQLType(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		assert.Equal(t, "SearchType!", graphQLType(reflect.TypeOf(SearchTypeIssue)))
+	})
+	t.Run("Case2", func(t *testing.T) {
+		assert.Equal(t, "[ID!]", graphQLType(reflect.TypeOf((*[]ID)(nil))))
+	})
+	t.Run("Case3", func(t *testing.T) {
+		assert.Equal(t, "AddStarInput!", graphQLType(reflect.TypeOf(AddStarInput{})))
+	})
+	t.Run("Case4", func(t *testing.T) {
+		assert.Equal(t, "Int", graphQLType(reflect.TypeOf((*int)(nil))))
+	})
+	t.Run("Case5", func(t *testing.T) {
+		assert.Equal(t, "DateTime!", graphQLType(reflect.TypeOf(time.Time{})))
+		assert.Equal(t, "DateTime", graphQLType(reflect.TypeOf((*time.Time)(nil))))
+		assert.Equal(t, "Base64String!", graphQLType(reflect.TypeOf([]byte(nil))))
+		assert.Equal(t, "Base64String", graphQLType(reflect.TypeOf((*[]byte)(nil))))
+		assert.Equal(t, "BigInt!", graphQLType(reflect.TypeOf(big.Int{})))
+		assert.Equal(t, "BigInt", graphQLType(reflect.TypeOf((*big.Int)(nil))))
+		assert.Equal(t, "URI!", graphQLType(reflect.TypeOf(url.URL{})))
+		assert.Equal(t, "[URI]!", graphQLType(reflect.TypeOf([]*url.URL(nil))))
+	})
+}
+
+func Test_isScalarStruct(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		assert.True(t, isScalarStruct(reflect.TypeOf(DateTime{})))
+	})
+	t.Run("Case2", func(t *testing.T) {
+		assert.True(t, isScalarStruct(reflect.TypeOf(ID{})))
+	})
+	t.Run("Case3", func(t *testing.T) {
+		assert.False(t, isScalarStruct(reflect.TypeOf(PageInfo{})))
+	})
+}
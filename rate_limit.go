@@ -0,0 +1,158 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jbrekelmans/go-graphql"
+)
+
+// rateLimitAlias is the response key of the rateLimit field selected by a *Client constructed with WithRateLimitCapture.
+// Root fields of queries must not use this response key.
+const rateLimitAlias = "githubv4RateLimit"
+
+// rateLimitSelection selects the rateLimit field under rateLimitAlias.
+const rateLimitSelection = rateLimitAlias + ": rateLimit{cost,limit,nodeCount,remaining,resetAt,used}"
+
+// dryRunRateLimitSelection selects the rateLimit field under rateLimitAlias, such that the query is not executed.
+const dryRunRateLimitSelection = rateLimitAlias + ": rateLimit(dryRun: true){cost,limit,nodeCount,remaining,resetAt,used}"
+
+// RateLimit is the rate limit status reported by the rateLimit field of a query.
+// See https://docs.github.com/en/graphql/overview/resource-limitations#rate-limit.
+type RateLimit struct {
+	// Cost is the rate limit cost of the query.
+	Cost int `json:"cost"`
+
+	// Limit is the maximum number of points that can be used in the current rate limit window.
+	Limit int `json:"limit"`
+
+	// NodeCount is the maximum number of nodes the query can return.
+	NodeCount int `json:"nodeCount"`
+
+	// Remaining is the number of points remaining in the current rate limit window.
+	Remaining int `json:"remaining"`
+
+	// ResetAt is the time at which the current rate limit window resets.
+	ResetAt DateTime `json:"resetAt"`
+
+	// Used is the number of points used in the current rate limit window.
+	Used int `json:"used"`
+}
+
+// RateLimit returns the rate limit status reported by the last response that selected the rateLimit field, or nil if there
+// was no such response. See WithRateLimitCapture.
+func (c *Client) RateLimit() *RateLimit {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	if c.rateLimit == nil {
+		return nil
+	}
+	rateLimit := *c.rateLimit
+	return &rateLimit
+}
+
+// setRateLimit records rateLimit as the rate limit status of the *Client.
+func (c *Client) setRateLimit(rateLimit *RateLimit) {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	c.rateLimit = rateLimit
+}
+
+// EstimateCost returns the rate limit cost and node count that GitHub computes for the query defined by q and variables, without
+// executing the query. q is not modified.
+// The query is sent with the rateLimit(dryRun: true) field added to it, which makes GitHub compute the cost of the query
+// instead of executing it. Unlike AnalyzeCost, the cost is computed by GitHub so it is accurate, but EstimateCost does
+// consume a request.
+//
+// See Query for more information on errors and responses.
+func (c *Client) EstimateCost(ctx context.Context, q any, variables any) (*RateLimit, *http.Response, error) {
+	vars, err := variablesMap(q, variables, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	name, err := operationName(ctx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	if md := metadataFromContext(ctx); md != nil {
+		*md = Metadata{
+			OperationName: name,
+		}
+	}
+	operation, err := constructOperation("query", name, q, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+	operation = injectField(operation, dryRunRateLimitSelection)
+	var rateLimit *RateLimit
+	resp, errItems, err := c.send(ctx, request{
+		Query:         operation,
+		Variables:     vars,
+		OperationName: name,
+	}, func(data []byte) (err error) {
+		_, rateLimit, err = extractRateLimit(data)
+		return
+	})
+	if err == nil && rateLimit == nil {
+		err = fmt.Errorf(`%d-response has no rateLimit field`, resp.StatusCode)
+	}
+	if err != nil {
+		return nil, resp, setOperationName(enhanceError(&graphql.Error{
+			Err:       err,
+			Errors:    errItems,
+			Message:   err.Error(),
+			Operation: operation,
+		}), name)
+	}
+	if md := metadataFromContext(ctx); md != nil {
+		md.RateLimit = rateLimit
+	}
+	return rateLimit, resp, nil
+}
+
+// injectRateLimit returns the query operation with the rateLimit field added to its root selection set under rateLimitAlias.
+func injectRateLimit(operation string) string {
+	return injectField(operation, rateLimitSelection)
+}
+
+// injectField returns operation with the field selected by selection added to its root selection set.
+func injectField(operation string, selection string) string {
+	i := strings.LastIndexByte(operation, '}')
+	if i < 0 {
+		return operation
+	}
+	var b strings.Builder
+	b.WriteString(operation[:i])
+	if i > 0 && operation[i-1] != '}' && operation[i-1] != '{' {
+		b.WriteByte(',')
+	}
+	b.WriteString(selection)
+	b.WriteString(operation[i:])
+	return b.String()
+}
+
+// extractRateLimit removes the rateLimit field selected under rateLimitAlias from response data.
+// Returns the data without the field and the decoded field, which is nil if data does not have the field.
+func extractRateLimit(data []byte) ([]byte, *RateLimit, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, nil, err
+	}
+	raw, ok := fields[rateLimitAlias]
+	if !ok {
+		return data, nil, nil
+	}
+	delete(fields, rateLimitAlias)
+	var rateLimit *RateLimit
+	if err := json.Unmarshal(raw, &rateLimit); err != nil {
+		return nil, nil, fmt.Errorf(`error decoding rateLimit field: %w`, err)
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, rateLimit, nil
+}
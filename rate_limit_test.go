@@ -0,0 +1,109 @@
+package githubv4
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_injectRateLimit(t *testing.T) {
+	assert.Equal(t, "query{viewer{login}githubv4RateLimit: rateLimit{cost,limit,nodeCount,remaining,resetAt,used}}",
+		injectRateLimit("query{viewer{login}}"))
+	assert.Equal(t, "query($id:ID!){node(id: $id){__typename,... on Issue{title}}githubv4RateLimit: rateLimit{cost,limit,"+
+		"nodeCount,remaining,resetAt,used}}", injectRateLimit("query($id:ID!){node(id: $id){__typename,... on Issue{title}}}"))
+	assert.Equal(t, "query{__typename,githubv4RateLimit: rateLimit{cost,limit,nodeCount,remaining,resetAt,used}}",
+		injectRateLimit("query{__typename}"))
+}
+
+func Test_Client_Query_RateLimitCapture(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query{viewer{login}githubv4RateLimit: rateLimit{cost,limit,nodeCount,remaining,resetAt,used}}",
+				req.Query)
+			return 200, `{"data":{"viewer":{"login":"octocat"},"githubv4RateLimit":{"cost":1,"limit":5000,"nodeCount":0,` +
+				`"remaining":4999,"resetAt":"2026-10-18T18:00:00Z","used":1}}}`
+		}, WithRateLimitCapture())
+		assert.Nil(t, c.RateLimit())
+		var q struct {
+			Viewer struct {
+				Login string
+			}
+		}
+		var md Metadata
+		_, err := c.Query(WithMetadata(context.Background(), &md), &q, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "octocat", q.Viewer.Login)
+			expected := &RateLimit{
+				Cost:      1,
+				Limit:     5000,
+				Remaining: 4999,
+				ResetAt:   DateTime{time.Date(2026, 10, 18, 18, 0, 0, 0, time.UTC)},
+				Used:      1,
+			}
+			assert.Equal(t, expected, md.RateLimit)
+			assert.Equal(t, expected, c.RateLimit())
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "mutation($input:AddStarInput!){addStar(input: $input){clientMutationId}}", req.Query)
+			return 200, `{"data":{"addStar":{"clientMutationId":null}}}`
+		}, WithRateLimitCapture())
+		var m struct {
+			AddStar struct {
+				ClientMutationID *string
+			} `graphql:"addStar(input: $input)"`
+		}
+		var md Metadata
+		_, err := c.Mutate(WithMetadata(context.Background(), &md), &m, AddStarInput{}, nil)
+		if assert.NoError(t, err) {
+			assert.Nil(t, md.RateLimit)
+			assert.Nil(t, c.RateLimit())
+		}
+	})
+}
+
+func Test_Client_EstimateCost(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query($owner:String!){repositoryOwner(login: $owner){repositories(first: 100){totalCount}}"+
+				"githubv4RateLimit: rateLimit(dryRun: true){cost,limit,nodeCount,remaining,resetAt,used}}", req.Query)
+			return 200, `{"data":{"repositoryOwner":null,"githubv4RateLimit":{"cost":1,"limit":5000,"nodeCount":100,` +
+				`"remaining":5000,"resetAt":"2026-10-18T18:00:00Z","used":0}}}`
+		}, WithRateLimitCapture())
+		var q struct {
+			RepositoryOwner struct {
+				Repositories struct {
+					TotalCount int
+				} `graphql:"repositories(first: 100)"`
+			} `graphql:"repositoryOwner(login: $owner)"`
+		}
+		rateLimit, _, err := c.EstimateCost(context.Background(), &q, map[string]any{
+			"owner": "octocat",
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, &RateLimit{
+				Cost:      1,
+				Limit:     5000,
+				NodeCount: 100,
+				Remaining: 5000,
+				ResetAt:   DateTime{time.Date(2026, 10, 18, 18, 0, 0, 0, time.UTC)},
+			}, rateLimit)
+			assert.Nil(t, c.RateLimit())
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return 200, `{"data":{"viewer":null}}`
+		})
+		var q struct {
+			Viewer struct {
+				Login string
+			}
+		}
+		_, _, err := c.EstimateCost(context.Background(), &q, nil)
+		assert.ErrorContains(t, err, "200-response has no rateLimit field")
+	})
+}
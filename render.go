@@ -0,0 +1,59 @@
+package githubv4
+
+import "encoding/json"
+
+// Render returns the GraphQL document and the JSON-encoded variables that Query sends for q and variables, without sending
+// anything. vars is nil if there are no variables.
+// This is useful for debugging and for golden-file tests of queries.
+//
+// Render renders like a *Client without options. The operation is named only if q implements OperationNamer, since Render
+// has no context for WithOperationName. The document that a *Client sends differs from the rendered document if the
+// *Client adds the rateLimit field (see WithRateLimitCapture), and the input that a *Client sends differs from the rendered
+// variables if the *Client sets client mutation IDs (see WithClientMutationIDs).
+func Render(q any, variables any) (document string, vars json.RawMessage, err error) {
+	variablesByName, err := variablesMap(q, variables, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	return render("query", q, variablesByName)
+}
+
+// RenderMutation is like Render, but for Mutate. See Render for differences with what a *Client sends.
+func RenderMutation(m any, input Input, variables any) (document string, vars json.RawMessage, err error) {
+	var inputs Inputs
+	if input != nil {
+		inputs = Inputs{"input": input}
+	}
+	variablesByName, err := variablesMap(m, variables, inputs)
+	if err != nil {
+		return "", nil, err
+	}
+	if input != nil {
+		v := make(map[string]any, len(variablesByName)+1)
+		for k, x := range variablesByName {
+			v[k] = x
+		}
+		v["input"] = input
+		variablesByName = v
+	}
+	return render("mutation", m, variablesByName)
+}
+
+func render(operationType string, q any, variables map[string]any) (string, json.RawMessage, error) {
+	name, err := namerOperationName(q)
+	if err != nil {
+		return "", nil, err
+	}
+	document, err := constructOperation(operationType, name, q, variables)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(variables) == 0 {
+		return document, nil, nil
+	}
+	vars, err := json.Marshal(jsonVariables(variables))
+	if err != nil {
+		return "", nil, err
+	}
+	return document, vars, nil
+}
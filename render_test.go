@@ -0,0 +1,69 @@
+package githubv4
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Render(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		var q struct {
+			Repository struct {
+				Issues struct {
+					Nodes []struct {
+						Title     string
+						CreatedAt DateTime
+					}
+					PageInfo PageInfo
+				} `graphql:"issues(first: 10, after: $cursor, states: $states)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}
+		document, vars, err := Render(&q, map[string]any{
+			"owner":  "octocat",
+			"name":   "Hello-World",
+			"cursor": (*string)(nil),
+			"states": []IssueState{IssueStateOpen},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "query($cursor:String,$name:String!,$owner:String!,$states:[IssueState!]!)"+
+				"{repository(owner: $owner, name: $name){issues(first: 10, after: $cursor, states: $states)"+
+				"{nodes{title,createdAt}pageInfo{endCursor,hasNextPage}}}}", document)
+			assert.JSONEq(t, `{"cursor":null,"name":"Hello-World","owner":"octocat","states":["OPEN"]}`, string(vars))
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		var q struct {
+			Viewer struct {
+				Login string
+			}
+		}
+		document, vars, err := Render(&q, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "query{viewer{login}}", document)
+			assert.Nil(t, vars)
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		_, _, err := Render(1, nil)
+		assert.ErrorContains(t, err, "invalid query type int")
+	})
+}
+
+func Test_RenderMutation(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		var m struct {
+			AddStar struct {
+				ClientMutationID *string
+			} `graphql:"addStar(input: $input)"`
+		}
+		variables := map[string]any{}
+		document, vars, err := RenderMutation(&m, AddStarInput{StarrableID: ID{S: "id1"}}, variables)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "mutation($input:AddStarInput!){addStar(input: $input){clientMutationId}}", document)
+			assert.Equal(t, json.RawMessage(`{"input":{"starrableId":"id1"}}`), vars)
+			assert.Empty(t, variables)
+		}
+	})
+}
@@ -17,6 +17,37 @@ import (
 // scalars as structs.
 // GraphQL scalars like Boolean, Float and Int are mapped to bool, float64 and int
 // types, and need not be defined as a struct.
+// Variable values of standard Go types time.Time, []byte, *big.Int and *url.URL
+// are declared as DateTime, Base64String, BigInt and URI respectively, so the
+// structs below are only needed to receive response data.
+// Boolean, Float, Int and String have the same names as in
+// github.com/shurcooL/githubv4 to ease migration, and are declared by their own
+// names. Code written against github.com/shurcooL/githubv4 does not compile
+// unchanged though: ID is a struct with field S instead of an interface{}, and
+// scalars such as GitObjectID, HTML, URI and Date are structs with field S
+// instead of strings or structs that embed *url.URL or time.Time. See package
+// compat and the analyzer of the migrate module.
+
+// Boolean represents a GraphQL scalar. Variables of type Boolean are declared as Boolean.
+//
+// Boolean represents true or false values.
+type Boolean bool
+
+// Float represents a GraphQL scalar. Variables of type Float are declared as Float.
+//
+// Float represents signed double-precision fractional values as specified by IEEE 754.
+type Float float64
+
+// Int represents a GraphQL scalar. Variables of type Int are declared as Int.
+//
+// Int represents non-fractional signed whole numeric values. Int can represent values between -(2^31) and 2^31 - 1.
+type Int int32
+
+// String represents a GraphQL scalar. Variables of type String are declared as String.
+//
+// String represents textual data as UTF-8 character sequences. This type is most often used by GraphQL to represent
+// free-form human-readable text.
+type String string
 
 // Base64String represents a GraphQL scalar. Use as the type for variables values in queries/mutations
 // to ensure the variable is declared as Base64String in GraphQL as expected by the GitHub API.
@@ -223,3 +254,52 @@ func (u *URI) UnmarshalText(b []byte) error {
 	u.S = string(b)
 	return nil
 }
+
+// NewBoolean is a helper to make a new *Boolean.
+func NewBoolean(v Boolean) *Boolean { return &v }
+
+// NewFloat is a helper to make a new *Float.
+func NewFloat(v Float) *Float { return &v }
+
+// NewInt is a helper to make a new *Int.
+func NewInt(v Int) *Int { return &v }
+
+// NewString is a helper to make a new *String.
+func NewString(v String) *String { return &v }
+
+// NewID is a helper to make a new *ID.
+func NewID(v ID) *ID { return &v }
+
+// NewBase64String is a helper to make a new *Base64String.
+func NewBase64String(v Base64String) *Base64String { return &v }
+
+// NewBigInt is a helper to make a new *BigInt. The new *BigInt does not share memory with v.
+func NewBigInt(v BigInt) *BigInt {
+	b := &BigInt{}
+	b.N.Set(&v.N)
+	return b
+}
+
+// NewDate is a helper to make a new *Date.
+func NewDate(v Date) *Date { return &v }
+
+// NewDateTime is a helper to make a new *DateTime.
+func NewDateTime(v DateTime) *DateTime { return &v }
+
+// NewGitObjectID is a helper to make a new *GitObjectID.
+func NewGitObjectID(v GitObjectID) *GitObjectID { return &v }
+
+// NewGitSSHRemote is a helper to make a new *GitSSHRemote.
+func NewGitSSHRemote(v GitSSHRemote) *GitSSHRemote { return &v }
+
+// NewGitTimestamp is a helper to make a new *GitTimestamp.
+func NewGitTimestamp(v GitTimestamp) *GitTimestamp { return &v }
+
+// NewHTML is a helper to make a new *HTML.
+func NewHTML(v HTML) *HTML { return &v }
+
+// NewPreciseDateTime is a helper to make a new *PreciseDateTime.
+func NewPreciseDateTime(v PreciseDateTime) *PreciseDateTime { return &v }
+
+// NewURI is a helper to make a new *URI.
+func NewURI(v URI) *URI { return &v }
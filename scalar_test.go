@@ -263,3 +263,57 @@ func Test_URI(t *testing.T) {
 		})
 	})
 }
+
+func Test_primitives(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		document, vars, err := Render(&struct {
+			Search struct {
+				IssueCount Int
+			} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+			Viewer struct {
+				Login      String
+				IsHireable Boolean
+			}
+		}{}, map[string]any{
+			"query": String("is:open"),
+			"first": Int(10),
+			"after": (*String)(nil),
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "query($after:String,$first:Int!,$query:String!){search(query: $query, type: ISSUE, first: $first, "+
+				"after: $after){issueCount}viewer{login,isHireable}}", document)
+			assert.JSONEq(t, `{"after":null,"first":10,"query":"is:open"}`, string(vars))
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		assert.Equal(t, String("x"), *NewString("x"))
+		assert.Equal(t, Int(1), *NewInt(1))
+		assert.Equal(t, Float(1.5), *NewFloat(1.5))
+		assert.Equal(t, Boolean(true), *NewBoolean(true))
+		assert.Equal(t, ID{S: "x"}, *NewID(ID{S: "x"}))
+		assert.Equal(t, URI{S: "https://github.com"}, *NewURI(URI{S: "https://github.com"}))
+		assert.Equal(t, "1", NewBigInt(BigInt{N: *big.NewInt(1)}).N.String())
+	})
+	t.Run("Case3", func(t *testing.T) {
+		var x struct {
+			Login      String
+			IssueCount Int
+			IsHireable Boolean
+		}
+		err := json.Unmarshal([]byte(`{"login":"octocat","issueCount":3,"isHireable":true}`), &x)
+		if assert.NoError(t, err) {
+			assert.Equal(t, String("octocat"), x.Login)
+			assert.Equal(t, Int(3), x.IssueCount)
+			assert.Equal(t, Boolean(true), x.IsHireable)
+		}
+	})
+	t.Run("Case4", func(t *testing.T) {
+		// The new *BigInt does not share memory with the argument.
+		var v BigInt
+		v.N.SetString("123456789012345678901234567890", 10)
+		p := NewBigInt(v)
+		p.N.SetInt64(5)
+		assert.Equal(t, "123456789012345678901234567890", v.N.String())
+		assert.Equal(t, "5", p.N.String())
+	})
+}
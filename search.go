@@ -0,0 +1,166 @@
+package githubv4
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// searchResultLimit is the maximum number of results that the search connection returns for a single search string.
+const searchResultLimit = 1000
+
+// SearchRequest defines a search for Search.
+type SearchRequest struct {
+	// Query is the search string, without a qualifier for DateQualifier.
+	Query string
+
+	// Type is the type of search.
+	Type SearchType
+
+	// DateQualifier is the qualifier used to split the search into date ranges, for example "created" or "updated".
+	DateQualifier string
+
+	// From is the (inclusive) start of the date range.
+	// If From is zero then the date range starts at 2008-01-01, before GitHub was launched.
+	From time.Time
+
+	// To is the (inclusive) end of the date range.
+	// If To is zero then the date range ends at the time Search is called.
+	To time.Time
+
+	// PageSize is the number of results per page. Defaults to 100.
+	PageSize int
+}
+
+// searchQuery is the query used by Search.
+// Nodes are selected twice: once with the selection set of the caller and once under the alias nodeIds to de-duplicate nodes.
+type searchQuery[T any] struct {
+	Search struct {
+		IssueCount      int
+		RepositoryCount int
+		UserCount       int
+		DiscussionCount int
+		PageInfo        PageInfo
+		Nodes           []T
+		NodeIDs         []struct {
+			Node struct {
+				ID ID
+			} `graphql:"... on Node"`
+		} `graphql:"nodeIds: nodes"`
+	} `graphql:"search(query: $query, type: $type, first: $first, after: $after)"`
+}
+
+// count returns the number of results of searchType. Returns false if searchType has no count field.
+func (q *searchQuery[T]) count(searchType SearchType) (int, bool) {
+	switch searchType {
+	case SearchTypeIssue:
+		return q.Search.IssueCount, true
+	case SearchTypeRepository:
+		return q.Search.RepositoryCount, true
+	case SearchTypeUser:
+		return q.Search.UserCount, true
+	case SearchTypeDiscussion:
+		return q.Search.DiscussionCount, true
+	}
+	return 0, false
+}
+
+// Search works around the limit of 1,000 results of GitHub's search connection.
+// Search queries the search connection for the date range of req. Whenever a date range has more than 1,000 results,
+// the date range is split in two halves that are searched recursively. The results of each date range are paginated
+// and passed to f, skipping nodes with IDs that were already passed to f.
+//
+// T defines the selection set of a search result, typically using inline fragments. For example:
+//
+//	type issue struct {
+//		Issue struct {
+//			Number int
+//			Title  string
+//		} `graphql:"... on Issue"`
+//	}
+//
+// Returns an error if req.Type is not a known SearchType, since the number of results (and thus whether to split a date
+// range) cannot be determined for an unknown type.
+// Returns an error if a date range of one second has more than 1,000 results, since such a range cannot be split further.
+// Returns the *http.Response of the last query. See (*Client).Query for more information on errors and responses.
+func Search[T any](ctx context.Context, c *Client, req SearchRequest, f func(node T) error) (*http.Response, error) {
+	if req.DateQualifier == "" {
+		return nil, fmt.Errorf(`error in Search: date qualifier is required`)
+	}
+	if _, ok := (&searchQuery[T]{}).count(req.Type); !ok {
+		return nil, fmt.Errorf(`error in Search: unsupported search type %#v`, string(req.Type))
+	}
+	if req.From.IsZero() {
+		req.From = time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if req.To.IsZero() {
+		req.To = time.Now()
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 100
+	}
+	s := &searcher[T]{
+		c:    c,
+		req:  req,
+		f:    f,
+		seen: map[string]struct{}{},
+	}
+	return s.searchRange(ctx, req.From.UTC().Truncate(time.Second), req.To.UTC().Truncate(time.Second))
+}
+
+type searcher[T any] struct {
+	c    *Client
+	req  SearchRequest
+	f    func(node T) error
+	seen map[string]struct{}
+}
+
+// searchRange searches the date range [from, to], where both bounds are inclusive and have a precision of one second.
+func (s *searcher[T]) searchRange(ctx context.Context, from, to time.Time) (*http.Response, error) {
+	query := strings.TrimSpace(fmt.Sprintf("%s %s:%s..%s", s.req.Query, s.req.DateQualifier,
+		from.Format(time.RFC3339), to.Format(time.RFC3339)))
+	variables := map[string]any{
+		"query": query,
+		"type":  s.req.Type,
+		"first": s.req.PageSize,
+		"after": (*string)(nil),
+	}
+	var q searchQuery[T]
+	for firstPage := true; ; firstPage = false {
+		q = searchQuery[T]{}
+		resp, err := s.c.Query(ctx, &q, variables)
+		if err != nil {
+			return resp, err
+		}
+		if count, _ := q.count(s.req.Type); firstPage && count > searchResultLimit {
+			if !from.Before(to) {
+				return resp, fmt.Errorf(`error in Search: search %#v has %d results, which exceeds the limit of %d`,
+					query, count, searchResultLimit)
+			}
+			mid := from.Add(to.Sub(from) / 2).Truncate(time.Second)
+			if resp, err := s.searchRange(ctx, from, mid); err != nil {
+				return resp, err
+			}
+			return s.searchRange(ctx, mid.Add(time.Second), to)
+		}
+		for i, node := range q.Search.Nodes {
+			if i < len(q.Search.NodeIDs) {
+				if id := q.Search.NodeIDs[i].Node.ID.S; id != "" {
+					if _, ok := s.seen[id]; ok {
+						continue
+					}
+					s.seen[id] = struct{}{}
+				}
+			}
+			if err := s.f(node); err != nil {
+				return resp, err
+			}
+		}
+		if !q.Search.PageInfo.HasNextPage {
+			return resp, nil
+		}
+		variables["after"] = q.Search.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,113 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Search(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		// Issue i was created at day i of January 2020.
+		// The ranges that are searched have 1937, 1000 and 1000 results, and the second range returns a duplicate.
+		rangeRegexp := regexp.MustCompile(`created:(\S+)\.\.(\S+)$`)
+		var queries []string
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			assert.Equal(t, "query($after:String,$first:Int!,$query:String!,$type:SearchType!)"+
+				"{search(query: $query, type: $type, first: $first, after: $after){issueCount,repositoryCount,userCount,"+
+				"discussionCount,pageInfo{endCursor,hasNextPage}nodes{... on Issue{number}}nodeIds: nodes{... on Node{id}}}}",
+				req.Query)
+			assert.JSONEq(t, `"ISSUE"`, string(req.Variables["type"]))
+			var query string
+			assert.NoError(t, json.Unmarshal(req.Variables["query"], &query))
+			queries = append(queries, query)
+			m := rangeRegexp.FindStringSubmatch(query)
+			from, _ := time.Parse(time.RFC3339, m[1])
+			to, _ := time.Parse(time.RFC3339, m[2])
+			var numbers []int
+			for day := 1; day <= 31; day++ {
+				created := time.Date(2020, time.January, day, 0, 0, 0, 0, time.UTC)
+				if !created.Before(from) && !created.After(to) {
+					numbers = append(numbers, day)
+				}
+			}
+			if m[1] == "2020-01-16T12:00:01Z" {
+				numbers = append(numbers, 1)
+			}
+			var nodes []string
+			for _, n := range numbers {
+				nodes = append(nodes, fmt.Sprintf(`{"number":%d}`, n))
+			}
+			var nodeIDs []string
+			for _, n := range numbers {
+				nodeIDs = append(nodeIDs, fmt.Sprintf(`{"id":"I%d"}`, n))
+			}
+			return http.StatusOK, fmt.Sprintf(`{"data":{"search":{"issueCount":%d,"repositoryCount":0,"userCount":0,`+
+				`"discussionCount":0,"pageInfo":{"endCursor":null,"hasNextPage":false},"nodes":[%s],"nodeIds":[%s]}}}`,
+				len(numbers)*1000/16, strings.Join(nodes, ","), strings.Join(nodeIDs, ","))
+		})
+		type issue struct {
+			Issue struct {
+				Number int
+			} `graphql:"... on Issue"`
+		}
+		var numbers []int
+		_, err := Search(context.Background(), c, SearchRequest{
+			Query:         "org:octo is:issue",
+			Type:          SearchTypeIssue,
+			DateQualifier: "created",
+			From:          time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			To:            time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC),
+		}, func(node issue) error {
+			numbers = append(numbers, node.Issue.Number)
+			return nil
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{
+				"org:octo is:issue created:2020-01-01T00:00:00Z..2020-02-01T00:00:00Z",
+				"org:octo is:issue created:2020-01-01T00:00:00Z..2020-01-16T12:00:00Z",
+				"org:octo is:issue created:2020-01-16T12:00:01Z..2020-02-01T00:00:00Z",
+			}, queries)
+			var expected []int
+			for day := 1; day <= 31; day++ {
+				expected = append(expected, day)
+			}
+			assert.Equal(t, expected, numbers)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"search":{"issueCount":0,"repositoryCount":1001,"userCount":0,` +
+				`"discussionCount":0,"pageInfo":{"endCursor":null,"hasNextPage":false},"nodes":[],"nodeIds":[]}}}`
+		})
+		instant := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		_, err := Search(context.Background(), c, SearchRequest{
+			Type:          SearchTypeRepository,
+			DateQualifier: "updated",
+			From:          instant,
+			To:            instant,
+		}, func(node struct{}) error {
+			return nil
+		})
+		assert.ErrorContains(t, err, "exceeds the limit of 1000")
+	})
+	t.Run("Case3", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			t.Error("unexpected request")
+			return http.StatusInternalServerError, ``
+		})
+		_, err := Search(context.Background(), c, SearchRequest{
+			DateQualifier: "created",
+		}, func(node struct{}) error {
+			return nil
+		})
+		assert.ErrorContains(t, err, `unsupported search type ""`)
+	})
+}
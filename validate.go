@@ -0,0 +1,135 @@
+package githubv4
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidationError is returned by the Validate method of input objects if an input is invalid, and by Mutate, MutateInputs and
+// BulkMutate if an input is invalid and the *Client validates inputs (see WithInputValidation).
+type ValidationError struct {
+	// Fields are the invalid fields, in the order of the fields of the inputs.
+	Fields []FieldError
+}
+
+var _ error = (*ValidationError)(nil)
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid input: ")
+	for i, f := range e.Fields {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(f.Error())
+	}
+	return b.String()
+}
+
+// FieldError describes an invalid field of an input.
+type FieldError struct {
+	// Path is the path of the field, e.g. "input.labelIds[1]". Paths of errors returned by Validate are relative to the input
+	// object, e.g. "labelIds[1]".
+	Path string
+
+	// Message describes the problem, e.g. "is required".
+	Message string
+}
+
+// Error implements the error interface.
+func (e FieldError) Error() string {
+	return e.Path + " " + e.Message
+}
+
+// inputValidator is implemented by generated input objects.
+type inputValidator interface {
+	validate(v *validator)
+}
+
+// validator collects the invalid fields of input objects. Generated input objects call the methods of validator to check
+// their fields.
+type validator struct {
+	path   string
+	fields []FieldError
+}
+
+// validateInput returns a *ValidationError if input x (with path root) is invalid, and nil otherwise.
+func validateInput(root string, x inputValidator) error {
+	v := validator{path: root}
+	x.validate(&v)
+	return v.err()
+}
+
+// validateInputs validates the inputs that are generated input objects, using variable names as the roots of paths.
+// Returns a *ValidationError if any input is invalid, and nil otherwise.
+func validateInputs(inputs Inputs) error {
+	varNames := make([]string, 0, len(inputs))
+	for varName := range inputs {
+		varNames = append(varNames, varName)
+	}
+	sort.Strings(varNames)
+	var v validator
+	for _, varName := range varNames {
+		x, ok := inputs[varName].(inputValidator)
+		if !ok {
+			continue
+		}
+		if rv := reflect.ValueOf(x); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			continue
+		}
+		v.input(varName, x)
+	}
+	return v.err()
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+func (v *validator) fieldPath(name string) string {
+	if v.path == "" {
+		return name
+	}
+	return v.path + "." + name
+}
+
+func (v *validator) report(name string, message string) {
+	v.fields = append(v.fields, FieldError{
+		Path:    v.fieldPath(name),
+		Message: message,
+	})
+}
+
+// missing reports that non-null field name is missing.
+func (v *validator) missing(name string) {
+	v.report(name, "is required")
+}
+
+// enum reports if field name has an enum value that is missing or not valid.
+func (v *validator) enum(name string, value string, valid bool) {
+	switch {
+	case value == "":
+		v.missing(name)
+	case !valid:
+		v.report(name, "has invalid value "+strconv.Quote(value))
+	}
+}
+
+// input validates field name, that is an input object.
+func (v *validator) input(name string, x inputValidator) {
+	saved := v.path
+	v.path = v.fieldPath(name)
+	x.validate(v)
+	v.path = saved
+}
+
+// index returns the name of element i of list field name.
+func index(name string, i int) string {
+	return name + "[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,93 @@
+package githubv4
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validate(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		input := UpdateIssueInput{
+			ID:    ID{S: "I0"},
+			Title: Some("t"),
+			State: Some(IssueStateClosed),
+		}
+		assert.NoError(t, input.Validate())
+	})
+	t.Run("Case2", func(t *testing.T) {
+		input := UpdateIssueInput{
+			LabelIDs: Some([]ID{{S: "L0"}, {}}),
+			State:    Some(IssueState("DELETED")),
+		}
+		err := input.Validate()
+		if assert.IsType(t, &ValidationError{}, err) {
+			assert.Equal(t, []FieldError{
+				{Path: "id", Message: "is required"},
+				{Path: "labelIds[1]", Message: "is required"},
+				{Path: "state", Message: `has invalid value "DELETED"`},
+			}, err.(*ValidationError).Fields)
+			assert.Equal(t, `invalid input: id is required; labelIds[1] is required; state has invalid value "DELETED"`,
+				err.Error())
+		}
+	})
+	t.Run("Case3", func(t *testing.T) {
+		input := CreateCommitOnBranchInput{
+			Branch: CommittableBranch{BranchName: Some("main")},
+			FileChanges: Some(FileChanges{
+				Additions: Some([]FileAddition{{Path: "a", Contents: Base64String{S: "YQ=="}}, {Path: "b"}}),
+			}),
+			ExpectedHeadOid: GitObjectID{S: "0123"},
+		}
+		err := input.Validate()
+		if assert.IsType(t, &ValidationError{}, err) {
+			assert.Equal(t, []FieldError{
+				{Path: "fileChanges.additions[1].contents", Message: "is required"},
+			}, err.(*ValidationError).Fields)
+		}
+	})
+}
+
+func Test_Client_Mutate_InputValidation(t *testing.T) {
+	var m struct {
+		AddStar struct {
+			ClientMutationID *string
+		} `graphql:"addStar(input: $input)"`
+	}
+	t.Run("Case1", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			t.Error("unexpected request")
+			return http.StatusInternalServerError, ``
+		}, WithInputValidation())
+		_, err := c.Mutate(context.Background(), &m, AddStarInput{}, nil)
+		if assert.IsType(t, &ValidationError{}, err) {
+			assert.Equal(t, []FieldError{{Path: "input.starrableId", Message: "is required"}}, err.(*ValidationError).Fields)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			return http.StatusOK, `{"data":{"addStar":{"clientMutationId":null}}}`
+		}, WithInputValidation())
+		_, err := c.Mutate(context.Background(), &m, &AddStarInput{StarrableID: ID{S: "R0"}}, nil)
+		assert.NoError(t, err)
+	})
+	t.Run("Case3", func(t *testing.T) {
+		var operations []string
+		c := newTestClient(t, func(req testRequest) (int, string) {
+			operations = append(operations, req.Query)
+			return http.StatusOK, `{"data":{"b0_addStar":{"clientMutationId":null}}}`
+		}, WithInputValidation())
+		results, err := c.BulkMutate(context.Background(), &m, []Input{
+			AddStarInput{},
+			AddStarInput{StarrableID: ID{S: "R0"}},
+		}, nil, nil)
+		assert.IsType(t, &ValidationError{}, err)
+		assert.Len(t, operations, 1)
+		if assert.Len(t, results, 2) {
+			assert.Same(t, err, results[0].Err)
+			assert.NoError(t, results[1].Err)
+		}
+	})
+}
@@ -0,0 +1,257 @@
+package githubv4
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jbrekelmans/go-graphql/mapping"
+)
+
+// typedVariable is a variable value that is declared with an explicit GraphQL type.
+type typedVariable struct {
+	value       any
+	graphQLType string
+}
+
+var _ json.Marshaler = typedVariable{}
+
+// MarshalJSON implements json.Marshaler.
+func (v typedVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// variableValue returns the value of variable value v, i.e. unwraps v if it is a typedVariable.
+func variableValue(v any) any {
+	if tv, ok := v.(typedVariable); ok {
+		return tv.value
+	}
+	return v
+}
+
+// setVariable sets the value of variable name in vars to value. If the variable was declared with an explicit GraphQL type
+// (see typedVariable) then the new value is declared with the same type.
+func setVariable(vars map[string]any, name string, value any) {
+	if tv, ok := vars[name].(typedVariable); ok {
+		vars[name] = typedVariable{
+			value:       value,
+			graphQLType: tv.graphQLType,
+		}
+		return
+	}
+	vars[name] = value
+}
+
+// variableType returns the GraphQL type that variable value v is declared as.
+func variableType(v any) string {
+	if tv, ok := v.(typedVariable); ok {
+		return tv.graphQLType
+	}
+	return graphQLType(reflect.TypeOf(v))
+}
+
+// standardScalars maps standard Go types to the GitHub scalars that variables with values of these types are declared as.
+var standardScalars = map[reflect.Type]string{
+	reflect.TypeOf(time.Time{}): "DateTime",
+	reflect.TypeOf([]byte(nil)): "Base64String",
+	reflect.TypeOf(big.Int{}):   "BigInt",
+	reflect.TypeOf(url.URL{}):   "URI",
+}
+
+// jsonVariables returns variables with values converted by jsonVariable, for encoding using encoding/json.
+func jsonVariables(variables map[string]any) map[string]any {
+	if len(variables) == 0 {
+		return variables
+	}
+	result := make(map[string]any, len(variables))
+	for varName, v := range variables {
+		result[varName] = jsonVariable(v)
+	}
+	return result
+}
+
+// jsonVariable converts variable value v to a value that encoding/json encodes as expected by GitHub.
+// Values of type big.Int and url.URL (or pointers to these types, or slices of these) are encoded as strings, instead of as a
+// JSON number and a JSON object respectively. time.Time and []byte are already encoded as GitHub expects.
+func jsonVariable(v any) any {
+	switch v := v.(type) {
+	case typedVariable:
+		return jsonVariable(v.value)
+	case big.Int:
+		return v.String()
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	case url.URL:
+		return v.String()
+	case *url.URL:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return v
+	}
+	elemType := rv.Type().Elem()
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType != reflect.TypeOf(big.Int{}) && elemType != reflect.TypeOf(url.URL{}) {
+		return v
+	}
+	if rv.Kind() == reflect.Slice && rv.IsNil() {
+		return nil
+	}
+	result := make([]any, rv.Len())
+	for i := range result {
+		result[i] = jsonVariable(rv.Index(i).Interface())
+	}
+	return result
+}
+
+// variablesMap converts the variables argument of Query (and others) to a map.
+// variables is either nil, a map with string keys (such as map[string]any), or a struct (or pointer to struct) that defines variables. A struct is verified
+// against q: each variable referenced by q must be defined by a field, and each field must be referenced by q.
+// Variables named by keys of inputs are considered defined.
+func variablesMap(q any, variables any, inputs Inputs) (map[string]any, error) {
+	switch variables := variables.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return variables, nil
+	}
+	v := reflect.ValueOf(variables)
+	if v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String {
+		// A map type such as map[string]string or a named map type.
+		if v.IsNil() {
+			return nil, nil
+		}
+		m := make(map[string]any, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			m[iter.Key().String()] = iter.Value().Interface()
+		}
+		return m, nil
+	}
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf(`invalid variables type %T, expected a map with string keys or a struct`, variables)
+	}
+	m := map[string]any{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		varName, varType, err := parseVariableTag(f)
+		if err != nil {
+			return nil, fmt.Errorf(`error in variables type %T: %w`, variables, err)
+		}
+		if varName == "-" {
+			continue
+		}
+		if _, ok := m[varName]; ok {
+			return nil, fmt.Errorf(`error in variables type %T: variable $%s is defined more than once`, variables, varName)
+		}
+		value := v.Field(i).Interface()
+		if varType != "" {
+			value = typedVariable{
+				value:       value,
+				graphQLType: varType,
+			}
+		}
+		m[varName] = value
+	}
+	referenced := map[string]bool{}
+	referencedVariables(reflect.TypeOf(q), referenced)
+	var missing, unused []string
+	for varName := range referenced {
+		if _, ok := m[varName]; !ok {
+			if _, ok := inputs[varName]; !ok {
+				missing = append(missing, varName)
+			}
+		}
+	}
+	for varName := range m {
+		if !referenced[varName] {
+			unused = append(unused, varName)
+		}
+	}
+	switch {
+	case len(missing) > 0:
+		sort.Strings(missing)
+		return nil, fmt.Errorf(`%T references variables that are not defined by variables type %T: $%s`, q, variables,
+			strings.Join(missing, ", $"))
+	case len(unused) > 0:
+		sort.Strings(unused)
+		return nil, fmt.Errorf(`variables type %T defines variables that are not referenced by %T: $%s`, variables, q,
+			strings.Join(unused, ", $"))
+	}
+	return m, nil
+}
+
+// parseVariableTag returns the variable name and explicit GraphQL type (if any) of a field of a variables struct.
+// The gqlvar tag has the form "name" or "name,type=Type". If the field has no tag (or the name is empty) then the name is
+// derived from the field name like the name of a field of a query struct, e.g. field RepositoryOwner defines variable
+// $repositoryOwner. A name of "-" means the field is not a variable.
+func parseVariableTag(f reflect.StructField) (varName string, varType string, err error) {
+	tag, _ := f.Tag.Lookup("gqlvar")
+	parts := strings.Split(tag, ",")
+	varName = strings.TrimSpace(parts[0])
+	for _, part := range parts[1:] {
+		part = strings.TrimSpace(part)
+		if !strings.HasPrefix(part, "type=") || part == "type=" {
+			return "", "", fmt.Errorf(`invalid gqlvar tag option %#v of field %s`, part, f.Name)
+		}
+		varType = part[len("type="):]
+	}
+	if varName == "" {
+		varName = mapping.NewFieldInfo(reflect.StructField{Name: f.Name, Type: f.Type}).FieldName()
+	}
+	return varName, varType, nil
+}
+
+// referencedVariables adds the names of variables referenced by the query struct type t to referenced.
+func referencedVariables(t reflect.Type, referenced map[string]bool) {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || isScalarStruct(t) {
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		x := mapping.NewFieldInfo(f)
+		if !x.Inline() {
+			graphQL := stringLiteralRegexp.ReplaceAllString(x.GraphQL(), `""`)
+			for {
+				i := strings.IndexByte(graphQL, '$')
+				if i < 0 {
+					break
+				}
+				graphQL = graphQL[i+1:]
+				n := 0
+				for n < len(graphQL) && isNameChar(graphQL[n]) {
+					n++
+				}
+				referenced[graphQL[:n]] = true
+				graphQL = graphQL[n:]
+			}
+		}
+		referencedVariables(f.Type, referenced)
+	}
+}
@@ -0,0 +1,148 @@
+package githubv4
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRepositoryQuery struct {
+	Repository struct {
+		Issues struct {
+			TotalCount int
+		} `graphql:"issues(first: $first, after: $after, labels: [\"$notAVariable\"])"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func Test_variablesMap(t *testing.T) {
+	t.Run("Case1", func(t *testing.T) {
+		after := "cursor"
+		variables := struct {
+			Owner    string
+			Name     string  `gqlvar:"name"`
+			First    int     `gqlvar:",type=Int"`
+			After    *string `gqlvar:"after,type=String"`
+			Debug    bool    `gqlvar:"-"`
+			internal bool
+		}{
+			Owner: "octocat",
+			Name:  "Hello-World",
+			First: 10,
+			After: &after,
+		}
+		m, err := variablesMap(&testRepositoryQuery{}, &variables, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]any{
+				"owner": "octocat",
+				"name":  "Hello-World",
+				"first": typedVariable{value: 10, graphQLType: "Int"},
+				"after": typedVariable{value: &after, graphQLType: "String"},
+			}, m)
+		}
+	})
+	t.Run("Case2", func(t *testing.T) {
+		variables := struct {
+			Owner string
+			First int
+		}{}
+		_, err := variablesMap(&testRepositoryQuery{}, variables, nil)
+		assert.ErrorContains(t, err, "references variables that are not defined by variables type struct { Owner string; "+
+			"First int }: $after, $name")
+	})
+	t.Run("Case3", func(t *testing.T) {
+		variables := struct {
+			Owner string
+			Name  string
+			First int
+			After *string
+			Last  int
+		}{}
+		_, err := variablesMap(&testRepositoryQuery{}, variables, nil)
+		assert.ErrorContains(t, err, "defines variables that are not referenced by *githubv4.testRepositoryQuery: $last")
+	})
+	t.Run("Case4", func(t *testing.T) {
+		var m struct {
+			AddStar struct {
+				ClientMutationID *string
+			} `graphql:"addStar(input: $input)"`
+		}
+		vars, err := variablesMap(&m, struct{}{}, Inputs{"input": nil})
+		if assert.NoError(t, err) {
+			assert.Empty(t, vars)
+		}
+	})
+	t.Run("Case5", func(t *testing.T) {
+		variables := map[string]any{
+			"unused": 1,
+		}
+		vars, err := variablesMap(&testRepositoryQuery{}, variables, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, variables, vars)
+		}
+	})
+	t.Run("Case6", func(t *testing.T) {
+		_, err := variablesMap(&testRepositoryQuery{}, 1, nil)
+		assert.ErrorContains(t, err, "invalid variables type int")
+	})
+	t.Run("Case7", func(t *testing.T) {
+		variables := struct {
+			Owner string `gqlvar:"owner,nullable"`
+		}{}
+		_, err := variablesMap(&testRepositoryQuery{}, variables, nil)
+		assert.ErrorContains(t, err, `invalid gqlvar tag option "nullable" of field Owner`)
+	})
+	t.Run("Case8", func(t *testing.T) {
+		type Vars map[string]any
+		vars, err := variablesMap(&testRepositoryQuery{}, Vars{"owner": "octocat", "first": 1}, nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]any{"owner": "octocat", "first": 1}, vars)
+		}
+	})
+}
+
+func Test_Client_Query_VariablesStruct(t *testing.T) {
+	c := newTestClient(t, func(req testRequest) (int, string) {
+		assert.Equal(t, "query($after:String,$first:Int!,$name:String!,$owner:String!){repository(owner: $owner, name: $name)"+
+			`{issues(first: $first, after: $after, labels: ["$notAVariable"]){totalCount}}}`, req.Query)
+		assert.JSONEq(t, `"octocat"`, string(req.Variables["owner"]))
+		assert.JSONEq(t, `null`, string(req.Variables["after"]))
+		return 200, `{"data":{"repository":{"issues":{"totalCount":3}}}}`
+	})
+	var q testRepositoryQuery
+	_, err := c.Query(context.Background(), &q, struct {
+		Owner string
+		Name  string
+		First int
+		After *string
+	}{
+		Owner: "octocat",
+		Name:  "Hello-World",
+		First: 10,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 3, q.Repository.Issues.TotalCount)
+	}
+}
+
+func Test_jsonVariables(t *testing.T) {
+	u, _ := url.Parse("https://github.com/octocat")
+	b, err := json.Marshal(jsonVariables(map[string]any{
+		"bigInt":   big.NewInt(12345678901234),
+		"nilInt":   (*big.Int)(nil),
+		"uri":      u,
+		"uris":     []*url.URL{u, nil},
+		"dateTime": time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		"bytes":    []byte("hello"),
+		"typed":    typedVariable{value: *u, graphQLType: "URI"},
+	}))
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"bigInt":"12345678901234","nilInt":null,"uri":"https://github.com/octocat",`+
+			`"uris":["https://github.com/octocat",null],"dateTime":"2026-10-18T12:00:00Z","bytes":"aGVsbG8=",`+
+			`"typed":"https://github.com/octocat"}`, string(b))
+	}
+}